@@ -20,6 +20,14 @@ func main() {
 	http.HandleFunc("/api/evaluate", handler.EnableCORS(handler.EvaluateHandler))
 	http.HandleFunc("/api/compare", handler.EnableCORS(handler.CompareHandler))
 	http.HandleFunc("/api/probability", handler.EnableCORS(handler.ProbabilityHandler))
+	http.HandleFunc("/api/equity", handler.EnableCORS(handler.EquityHandler))
+	http.HandleFunc("/api/strength", handler.EnableCORS(handler.StrengthHandler))
+	http.HandleFunc("/api/showdown", handler.EnableCORS(handler.ShowdownHandler))
+	http.HandleFunc("/api/runouts", handler.EnableCORS(handler.RunoutHandler))
+	http.HandleFunc("/api/outs", handler.EnableCORS(handler.OutsHandler))
+	http.HandleFunc("/api/texture", handler.EnableCORS(handler.TextureHandler))
+	http.HandleFunc("/api/stud/evaluate", handler.EnableCORS(handler.StudEvaluateHandler))
+	http.HandleFunc("/api/stud/probability", handler.EnableCORS(handler.StudProbabilityHandler))
 
 	addr := fmt.Sprintf(":%s", port)
 	log.Printf("Starting poker API server on %s", addr)
@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"poker-app/internal/poker"
+)
+
+// EquityRequest represents the request body for /api/equity
+type EquityRequest struct {
+	Game           string     `json:"game"`    // holdem (default), omaha, shortdeck, ...
+	Players        [][]string `json:"players"` // each player's hole cards
+	CommunityCards []string   `json:"communityCards"`
+	Simulations    int        `json:"simulations"`
+	Mode           string     `json:"mode"` // montecarlo (default), exact or auto
+	Seed           *int64     `json:"seed"` // replays an earlier simulation; random when omitted
+}
+
+// EquityResponse represents the response for /api/equity. Player indices
+// refer to positions in the request's players list.
+type EquityResponse struct {
+	Players     []poker.PlayerEquity `json:"players"`
+	Simulations int                  `json:"simulations"`
+	Exact       bool                 `json:"exact"`          // every board was enumerated; simulations counts them
+	Seed        *int64               `json:"seed,omitempty"` // seed that replays this simulation; omitted when exact
+	Success     bool                 `json:"success"`
+	Error       string               `json:"error,omitempty"`
+}
+
+// EquityHandler calculates the equity of every player when all hole cards
+// are known
+func EquityHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req EquityRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		sendError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	game, err := poker.ParseGame(req.Game)
+	if err != nil {
+		sendError(w, fmt.Sprintf("Invalid game: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	mode, err := poker.ParseMode(req.Mode)
+	if err != nil {
+		sendError(w, fmt.Sprintf("Invalid mode: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	holes := make([][]poker.Card, len(req.Players))
+	for i, cards := range req.Players {
+		holes[i], err = poker.ParseCards(cards)
+		if err != nil {
+			sendError(w, fmt.Sprintf("Invalid player %d hole cards: %v", i+1, err), http.StatusBadRequest)
+			return
+		}
+	}
+
+	communityCards, err := poker.ParseCards(req.CommunityCards)
+	if err != nil {
+		sendError(w, fmt.Sprintf("Invalid community cards: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	opts := []poker.ProbabilityOption{poker.WithGame(game), poker.WithMode(mode)}
+	if req.Seed != nil {
+		opts = append(opts, poker.WithSeed(*req.Seed))
+	}
+	result, err := poker.CalculateEquity(holes, communityCards, req.Simulations, opts...)
+	if err != nil {
+		sendError(w, fmt.Sprintf("Error calculating equity: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	response := EquityResponse{
+		Players:     result.Players,
+		Simulations: result.Simulations,
+		Exact:       result.Exact,
+		Success:     true,
+	}
+	if !result.Exact {
+		response.Seed = &result.Seed
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"poker-app/internal/poker"
 )
@@ -31,10 +33,18 @@ func RootHandler(w http.ResponseWriter, r *http.Request) {
 		"name":    "Texas Hold'em Poker API",
 		"version": "1.0.0",
 		"endpoints": map[string]string{
-			"GET /health":           "Health check",
-			"POST /api/evaluate":    "Evaluate poker hand",
-			"POST /api/compare":     "Compare two poker hands",
-			"POST /api/probability": "Calculate win probability",
+			"GET /health":                "Health check",
+			"POST /api/evaluate":         "Evaluate poker hand",
+			"POST /api/compare":          "Compare two poker hands",
+			"POST /api/probability":      "Calculate win probability",
+			"POST /api/equity":           "Calculate every player's equity with known hole cards",
+			"GET /api/strength":          "Describe a hand strength class",
+			"POST /api/showdown":         "Rank the hands of 2 to 10 players at showdown",
+			"POST /api/runouts":          "Show how every next community card changes the hero's equity",
+			"POST /api/outs":             "Count the hero's outs and name their draws",
+			"POST /api/texture":          "Describe a board's texture and the hands it allows",
+			"POST /api/stud/evaluate":    "Evaluate and compare seven card stud hands",
+			"POST /api/stud/probability": "Calculate seven card stud equity",
 		},
 		"documentation": "See README.md for API details",
 	}
@@ -56,17 +66,43 @@ func HealthHandler(w http.ResponseWriter, r *http.Request) {
 
 // EvaluateRequest represents the request body for /api/evaluate
 type EvaluateRequest struct {
+	Game           string   `json:"game"`
 	HoleCards      []string `json:"holeCards"`
 	CommunityCards []string `json:"communityCards"`
+	Format         string   `json:"format"` // card output style: glyph (default), ascii, suit-rank or unicode
 }
 
 // EvaluateResponse represents the response for /api/evaluate
 type EvaluateResponse struct {
-	HandRank    string   `json:"handRank"`
-	Description string   `json:"description"`
-	Cards       []string `json:"cards"`
-	Success     bool     `json:"success"`
-	Error       string   `json:"error,omitempty"`
+	HandRank    poker.HandRank     `json:"handRank"`
+	Description string             `json:"description"`
+	Cards       []string           `json:"cards"`
+	Strength    poker.HandStrength `json:"strength,omitempty"` // left out for lowball hands, which have no strength class
+	Breakdown   []HandCardResponse `json:"breakdown"`
+	PlaysBoard  bool               `json:"playsBoard"`
+	Low         *LowHandResponse   `json:"low,omitempty"`
+
+	// Classification describes the hand relative to a flop or turn in games
+	// with two hole cards
+	Classification *poker.HandClass `json:"classification,omitempty"`
+
+	Success bool   `json:"success"`
+	Error   string `json:"error,omitempty"`
+}
+
+// HandCardResponse describes one card of the best five: where it came from
+// and whether it makes the hand or is a kicker
+type HandCardResponse struct {
+	Card   string           `json:"card"`
+	Source poker.CardSource `json:"source"` // "hole" or "board"
+	Role   poker.CardRole   `json:"role"`   // "core" or "kicker"
+}
+
+// LowHandResponse describes the low half of a hi/lo hand
+type LowHandResponse struct {
+	Qualifies   bool     `json:"qualifies"`
+	Description string   `json:"description,omitempty"`
+	Cards       []string `json:"cards,omitempty"`
 }
 
 // EvaluateHandler handles hand evaluation requests
@@ -82,6 +118,18 @@ func EvaluateHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	game, err := poker.ParseGame(req.Game)
+	if err != nil {
+		sendError(w, fmt.Sprintf("Invalid game: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	format, err := poker.ParseCardFormat(req.Format)
+	if err != nil {
+		sendError(w, fmt.Sprintf("Invalid format: %v", err), http.StatusBadRequest)
+		return
+	}
+
 	// Parse hole cards
 	holeCards, err := poker.ParseCards(req.HoleCards)
 	if err != nil {
@@ -102,26 +150,42 @@ func EvaluateHandler(w http.ResponseWriter, r *http.Request) {
 		sendError(w, "Need at least 5 cards total", http.StatusBadRequest)
 		return
 	}
+	if poker.HasDuplicates(allCards) {
+		sendError(w, "Duplicate cards detected", http.StatusBadRequest)
+		return
+	}
 
 	// Evaluate hand
-	hand, err := poker.EvaluateHand(allCards)
+	hand, err := game.Evaluate(holeCards, communityCards)
 	if err != nil {
-		sendError(w, fmt.Sprintf("Error evaluating hand: %v", err), http.StatusInternalServerError)
+		sendError(w, fmt.Sprintf("Error evaluating hand: %v", err), http.StatusBadRequest)
 		return
 	}
 
 	// Build response
-	cardStrings := make([]string, len(hand.Cards))
-	for i, card := range hand.Cards {
-		cardStrings[i] = card.String()
-	}
-
 	response := EvaluateResponse{
-		HandRank:    hand.Rank.String(),
+		HandRank:    hand.Rank,
 		Description: hand.Description,
-		Cards:       cardStrings,
+		Cards:       poker.FormatCards(hand.Cards, format),
+		Strength:    hand.Strength(),
+		Breakdown:   breakdownResponse(hand, holeCards, format),
+		PlaysBoard:  hand.PlaysBoard(holeCards),
 		Success:     true,
 	}
+	if game.IsHiLo() {
+		hilo, err := game.EvaluateHiLo(holeCards, communityCards)
+		if err != nil {
+			sendError(w, fmt.Sprintf("Error evaluating hand: %v", err), http.StatusBadRequest)
+			return
+		}
+		response.Low = lowHandResponse(hilo.Low, format)
+	}
+	// Classification is an optional extra: leave it out wherever it does not apply
+	if n := len(communityCards); (n == 3 || n == 4) && len(holeCards) == 2 && game.HoleCards() == 2 && game.HasBoard() {
+		if class, err := game.Classify(holeCards, communityCards); err == nil {
+			response.Classification = class
+		}
+	}
 
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(response)
@@ -129,23 +193,47 @@ func EvaluateHandler(w http.ResponseWriter, r *http.Request) {
 
 // CompareRequest represents the request body for /api/compare
 type CompareRequest struct {
+	Game                  string   `json:"game"`
 	Player1HoleCards      []string `json:"player1HoleCards"`
 	Player1CommunityCards []string `json:"player1CommunityCards"`
 	Player2HoleCards      []string `json:"player2HoleCards"`
 	Player2CommunityCards []string `json:"player2CommunityCards"`
+	Format                string   `json:"format"` // card output style: glyph (default), ascii, suit-rank or unicode
 }
 
 // CompareResponse represents the response for /api/compare
 type CompareResponse struct {
-	Player1Hand        string   `json:"player1Hand"`
-	Player1Description string   `json:"player1Description"`
-	Player1Cards       []string `json:"player1Cards"`
-	Player2Hand        string   `json:"player2Hand"`
-	Player2Description string   `json:"player2Description"`
-	Player2Cards       []string `json:"player2Cards"`
-	Winner             string   `json:"winner"`
-	Success            bool     `json:"success"`
-	Error              string   `json:"error,omitempty"`
+	Player1Hand        poker.HandRank `json:"player1Hand"`
+	Player1Description string         `json:"player1Description"`
+	Player1Cards       []string       `json:"player1Cards"`
+	Player2Hand        poker.HandRank `json:"player2Hand"`
+	Player2Description string         `json:"player2Description"`
+	Player2Cards       []string       `json:"player2Cards"`
+	Winner             string         `json:"winner"`
+	Decider            string         `json:"decider"`     // "category", the deciding tiebreaker such as "kicker", or "tie"
+	Explanation        string         `json:"explanation"` // such as "kicker decides: King vs Queen"
+
+	Player1Breakdown  []HandCardResponse `json:"player1Breakdown"`
+	Player1PlaysBoard bool               `json:"player1PlaysBoard"`
+	Player2Breakdown  []HandCardResponse `json:"player2Breakdown"`
+	Player2PlaysBoard bool               `json:"player2PlaysBoard"`
+
+	// Hi/lo games only
+	Player1Low *LowHandResponse  `json:"player1Low,omitempty"`
+	Player2Low *LowHandResponse  `json:"player2Low,omitempty"`
+	Split      *SplitPotResponse `json:"split,omitempty"`
+
+	Success bool   `json:"success"`
+	Error   string `json:"error,omitempty"`
+}
+
+// SplitPotResponse reports how a hi/lo pot is divided
+type SplitPotResponse struct {
+	HighWinner   string  `json:"highWinner"`
+	LowWinner    string  `json:"lowWinner"` // "none" when no hand qualifies for low
+	Player1Share float64 `json:"player1Share"`
+	Player2Share float64 `json:"player2Share"`
+	Scoop        bool    `json:"scoop"`
 }
 
 // CompareHandler handles hand comparison requests
@@ -161,6 +249,18 @@ func CompareHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	game, err := poker.ParseGame(req.Game)
+	if err != nil {
+		sendError(w, fmt.Sprintf("Invalid game: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	format, err := poker.ParseCardFormat(req.Format)
+	if err != nil {
+		sendError(w, fmt.Sprintf("Invalid format: %v", err), http.StatusBadRequest)
+		return
+	}
+
 	// Parse player 1 cards
 	p1HoleCards, err := poker.ParseCards(req.Player1HoleCards)
 	if err != nil {
@@ -192,7 +292,7 @@ func CompareHandler(w http.ResponseWriter, r *http.Request) {
 	// 3. No overlap between player 1 hole cards and player 2 hole cards
 	// 4. No overlap between hole cards and community cards (for each player)
 	allUniqueCards := append(append(p1HoleCards, p2HoleCards...), p1CommunityCards...)
-	if hasDuplicates(allUniqueCards) {
+	if poker.HasDuplicates(allUniqueCards) {
 		sendError(w, "Duplicate cards detected", http.StatusBadRequest)
 		return
 	}
@@ -206,22 +306,22 @@ func CompareHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	hand1, err := poker.EvaluateHand(p1AllCards)
+	hand1, err := game.Evaluate(p1HoleCards, p1CommunityCards)
 	if err != nil {
-		sendError(w, fmt.Sprintf("Error evaluating player 1 hand: %v", err), http.StatusInternalServerError)
+		sendError(w, fmt.Sprintf("Error evaluating player 1 hand: %v", err), http.StatusBadRequest)
 		return
 	}
 
-	hand2, err := poker.EvaluateHand(p2AllCards)
+	hand2, err := game.Evaluate(p2HoleCards, p2CommunityCards)
 	if err != nil {
-		sendError(w, fmt.Sprintf("Error evaluating player 2 hand: %v", err), http.StatusInternalServerError)
+		sendError(w, fmt.Sprintf("Error evaluating player 2 hand: %v", err), http.StatusBadRequest)
 		return
 	}
 
 	// Compare hands
-	result := hand1.Compare(hand2)
+	comparison := hand1.Explain(hand2)
 	var winner string
-	switch result {
+	switch comparison.Result {
 	case 1:
 		winner = "player1"
 	case -1:
@@ -230,48 +330,93 @@ func CompareHandler(w http.ResponseWriter, r *http.Request) {
 		winner = "tie"
 	}
 
-	// Build card strings for both players
-	player1CardStrings := make([]string, len(hand1.Cards))
-	for i, card := range hand1.Cards {
-		player1CardStrings[i] = card.String()
-	}
-
-	player2CardStrings := make([]string, len(hand2.Cards))
-	for i, card := range hand2.Cards {
-		player2CardStrings[i] = card.String()
-	}
-
 	response := CompareResponse{
-		Player1Hand:        hand1.Rank.String(),
+		Player1Hand:        hand1.Rank,
 		Player1Description: hand1.Description,
-		Player1Cards:       player1CardStrings,
-		Player2Hand:        hand2.Rank.String(),
+		Player1Cards:       poker.FormatCards(hand1.Cards, format),
+		Player2Hand:        hand2.Rank,
 		Player2Description: hand2.Description,
-		Player2Cards:       player2CardStrings,
+		Player2Cards:       poker.FormatCards(hand2.Cards, format),
 		Winner:             winner,
+		Decider:            comparison.Decider,
+		Explanation:        comparison.Explanation,
+		Player1Breakdown:   breakdownResponse(hand1, p1HoleCards, format),
+		Player1PlaysBoard:  hand1.PlaysBoard(p1HoleCards),
+		Player2Breakdown:   breakdownResponse(hand2, p2HoleCards, format),
+		Player2PlaysBoard:  hand2.PlaysBoard(p2HoleCards),
 		Success:            true,
 	}
 
+	if game.IsHiLo() {
+		hilo1, err := game.EvaluateHiLo(p1HoleCards, p1CommunityCards)
+		if err != nil {
+			sendError(w, fmt.Sprintf("Error evaluating player 1 hand: %v", err), http.StatusBadRequest)
+			return
+		}
+		hilo2, err := game.EvaluateHiLo(p2HoleCards, p2CommunityCards)
+		if err != nil {
+			sendError(w, fmt.Sprintf("Error evaluating player 2 hand: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		pot := poker.SplitHiLoPot([]*poker.HiLoHand{hilo1, hilo2})
+		response.Player1Low = lowHandResponse(hilo1.Low, format)
+		response.Player2Low = lowHandResponse(hilo2.Low, format)
+		response.Split = &SplitPotResponse{
+			HighWinner:   winnerName(pot.HighWinners),
+			LowWinner:    winnerName(pot.LowWinners),
+			Player1Share: pot.Shares[0],
+			Player2Share: pot.Shares[1],
+			Scoop:        pot.Scooper >= 0,
+		}
+		if pot.Scooper < 0 {
+			response.Winner = "split"
+		}
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(response)
 }
 
 // ProbabilityRequest represents the request body for /api/probability
 type ProbabilityRequest struct {
+	Game           string   `json:"game"`
 	HoleCards      []string `json:"holeCards"`
 	CommunityCards []string `json:"communityCards"`
 	NumPlayers     int      `json:"numPlayers"`
 	Simulations    int      `json:"simulations"`
+	OpponentRanges []string `json:"opponentRanges"` // one range per opponent, such as "TT+, AQs+"; "" for a random hand
+	Mode           string   `json:"mode"`           // montecarlo (default), exact or auto
+	Seed           *int64   `json:"seed"`           // replays an earlier simulation; random when omitted
+
+	TargetPrecision float64 `json:"targetPrecision"` // simulate until every 95% interval is this narrow; simulations is then the limit
 }
 
+// defaultPrecisionLimit caps a target precision request that gives no
+// simulation count
+const defaultPrecisionLimit = 1_000_000
+
 // ProbabilityResponse represents the response for /api/probability
 type ProbabilityResponse struct {
 	WinProbability  float64 `json:"winProbability"`
 	TieProbability  float64 `json:"tieProbability"`
 	LossProbability float64 `json:"lossProbability"`
 	Simulations     int     `json:"simulations"`
-	Success         bool    `json:"success"`
-	Error           string  `json:"error,omitempty"`
+	Exact           bool    `json:"exact"`             // every deal was enumerated; simulations counts them
+	Partial         bool    `json:"partial,omitempty"` // stopped early; simulations counts the hands completed
+	Seed            *int64  `json:"seed,omitempty"`    // seed that replays this simulation; omitted when exact
+
+	Counts *poker.OutcomeCounts `json:"counts,omitempty"` // weighted win, tie and loss deal counts; exact runs only
+
+	Precision        *poker.Precision `json:"precision,omitempty"`        // standard errors and 95% intervals; omitted when exact
+	PrecisionReached bool             `json:"precisionReached,omitempty"` // targetPrecision was met within the limit
+
+	Categories *poker.CategoryBreakdown `json:"categories,omitempty"` // how often the hero and best opponent make each hand
+
+	HiLo *poker.HiLoResult `json:"hiLo,omitempty"`
+
+	Success bool   `json:"success"`
+	Error   string `json:"error,omitempty"`
 }
 
 // ProbabilityHandler handles win probability calculation requests
@@ -287,6 +432,12 @@ func ProbabilityHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	game, err := poker.ParseGame(req.Game)
+	if err != nil {
+		sendError(w, fmt.Sprintf("Invalid game: %v", err), http.StatusBadRequest)
+		return
+	}
+
 	// Parse cards
 	holeCards, err := poker.ParseCards(req.HoleCards)
 	if err != nil {
@@ -300,9 +451,46 @@ func ProbabilityHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Calculate probability
-	result, err := poker.CalculateWinProbability(holeCards, communityCards, req.NumPlayers, req.Simulations)
+	mode, err := poker.ParseMode(req.Mode)
 	if err != nil {
+		sendError(w, fmt.Sprintf("Invalid mode: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	opts := []poker.ProbabilityOption{poker.WithGame(game), poker.WithMode(mode)}
+	if req.Seed != nil {
+		opts = append(opts, poker.WithSeed(*req.Seed))
+	}
+	if req.TargetPrecision != 0 {
+		opts = append(opts, poker.WithTargetPrecision(req.TargetPrecision))
+		if req.Simulations == 0 {
+			req.Simulations = defaultPrecisionLimit
+		}
+	}
+	if len(req.OpponentRanges) > 0 {
+		ranges := make([]*poker.Range, len(req.OpponentRanges))
+		for i, spec := range req.OpponentRanges {
+			if strings.TrimSpace(spec) == "" {
+				continue
+			}
+			ranges[i], err = poker.ParseRange(spec)
+			if err != nil {
+				sendError(w, fmt.Sprintf("Invalid range for opponent %d: %v", i+1, err), http.StatusBadRequest)
+				return
+			}
+		}
+		if req.NumPlayers == 0 {
+			req.NumPlayers = len(ranges) + 1
+		}
+		opts = append(opts, poker.WithOpponentRanges(ranges...))
+	}
+
+	// Calculate probability, stopping if the client goes away
+	result, err := poker.CalculateWinProbabilityContext(r.Context(), holeCards, communityCards,
+		req.NumPlayers, req.Simulations, opts...)
+	if result != nil && result.Partial {
+		log.Printf("Probability calculation stopped after %d simulations: %v", result.Simulations, err)
+	} else if err != nil {
 		sendError(w, fmt.Sprintf("Error calculating probability: %v", err), http.StatusBadRequest)
 		return
 	}
@@ -311,28 +499,115 @@ func ProbabilityHandler(w http.ResponseWriter, r *http.Request) {
 		result.WinProbability*100, result.TieProbability*100, result.LossProbability*100)
 
 	response := ProbabilityResponse{
-		WinProbability:  result.WinProbability,
-		TieProbability:  result.TieProbability,
-		LossProbability: result.LossProbability,
-		Simulations:     result.Simulations,
-		Success:         true,
+		WinProbability:   result.WinProbability,
+		TieProbability:   result.TieProbability,
+		LossProbability:  result.LossProbability,
+		Simulations:      result.Simulations,
+		Exact:            result.Exact,
+		Partial:          result.Partial,
+		Counts:           result.Counts,
+		Precision:        result.Precision,
+		PrecisionReached: result.PrecisionReached,
+		Categories:       result.Categories,
+		HiLo:             result.HiLo,
+		Success:          true,
+	}
+	if !result.Exact {
+		response.Seed = &result.Seed
 	}
 
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(response)
 }
 
-// hasDuplicates checks if there are duplicate cards in the slice
-func hasDuplicates(cards []poker.Card) bool {
-	seen := make(map[string]bool)
-	for _, card := range cards {
-		key := fmt.Sprintf("%d%s", card.Rank, card.Suit)
-		if seen[key] {
-			return true
-		}
-		seen[key] = true
+// StrengthResponse represents the response for /api/strength
+type StrengthResponse struct {
+	Strength    poker.HandStrength `json:"strength"`
+	HandRank    poker.HandRank     `json:"handRank"`
+	Description string             `json:"description"`
+	Cards       []string           `json:"cards"`
+	Success     bool               `json:"success"`
+	Error       string             `json:"error,omitempty"`
+}
+
+// StrengthHandler maps a hand strength class (?value=1..7462, optionally
+// with ?game= and ?format=) back to a canonical hand
+func StrengthHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	game, err := poker.ParseGame(r.URL.Query().Get("game"))
+	if err != nil {
+		sendError(w, fmt.Sprintf("Invalid game: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	format, err := poker.ParseCardFormat(r.URL.Query().Get("format"))
+	if err != nil {
+		sendError(w, fmt.Sprintf("Invalid format: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	if game.IsLowball() {
+		sendError(w, fmt.Sprintf("Invalid game: %s hands have no strength classes", game), http.StatusBadRequest)
+		return
+	}
+
+	value, err := strconv.Atoi(r.URL.Query().Get("value"))
+	if err != nil || value < 1 || value > int(game.MaxStrength()) {
+		sendError(w, fmt.Sprintf("Strength value must be between 1 and %d", game.MaxStrength()), http.StatusBadRequest)
+		return
+	}
+
+	hand, err := game.DescribeStrength(poker.HandStrength(value))
+	if err != nil {
+		sendError(w, fmt.Sprintf("Error describing strength: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	response := StrengthResponse{
+		Strength:    hand.Strength(),
+		HandRank:    hand.Rank,
+		Description: hand.Description,
+		Cards:       poker.FormatCards(hand.Cards, format),
+		Success:     true,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// breakdownResponse attributes each card of a hand to the hole cards or the
+// board and marks it as core or kicker
+func breakdownResponse(hand *poker.Hand, holeCards []poker.Card, format poker.CardFormat) []HandCardResponse {
+	cards := hand.Attribute(holeCards)
+	breakdown := make([]HandCardResponse, len(cards))
+	for i, card := range cards {
+		breakdown[i] = HandCardResponse{Card: card.Card.Format(format), Source: card.Source, Role: card.Role}
+	}
+	return breakdown
+}
+
+// lowHandResponse describes a qualifying low hand, or its absence when nil
+func lowHandResponse(low *poker.Hand, format poker.CardFormat) *LowHandResponse {
+	if low == nil {
+		return &LowHandResponse{Qualifies: false}
+	}
+	return &LowHandResponse{Qualifies: true, Description: low.Description, Cards: poker.FormatCards(low.Cards, format)}
+}
+
+// winnerName names the heads-up winner from the winning player indices
+func winnerName(winners []int) string {
+	switch len(winners) {
+	case 0:
+		return "none"
+	case 1:
+		return fmt.Sprintf("player%d", winners[0]+1)
+	default:
+		return "tie"
 	}
-	return false
 }
 
 // sendError sends an error response
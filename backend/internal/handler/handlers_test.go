@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestEvaluateHandler_Classification(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		classified bool
+	}{
+		{"two hole cards on the flop", `{"holeCards":["AH","KH"],"communityCards":["KC","7S","2D"]}`, true},
+		{"one hole card on the turn", `{"holeCards":["AH"],"communityCards":["KC","7S","2D","9H"]}`, false},
+		{"three hole cards on the flop", `{"holeCards":["AH","KH","QH"],"communityCards":["KC","7S","2D"]}`, false},
+		{"two hole cards on the river", `{"holeCards":["AH","KH"],"communityCards":["KC","7S","2D","9H","3C"]}`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/evaluate", strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+			EvaluateHandler(rec, req)
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+			}
+			var response EvaluateResponse
+			if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+				t.Fatalf("Decoding response: %v", err)
+			}
+			if !response.Success {
+				t.Errorf("Expected success, got error %q", response.Error)
+			}
+			if (response.Classification != nil) != tt.classified {
+				t.Errorf("Expected classification %v, got %+v", tt.classified, response.Classification)
+			}
+		})
+	}
+}
+
+func TestEvaluateHandler_LowballHasNoStrength(t *testing.T) {
+	body := `{"game":"razz","holeCards":["AH","2D","3C","4S","5H","KD","KC"]}`
+	req := httptest.NewRequest(http.MethodPost, "/api/evaluate", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	EvaluateHandler(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var response map[string]interface{}
+	if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+		t.Fatalf("Decoding response: %v", err)
+	}
+	if _, ok := response["strength"]; ok {
+		t.Errorf("Expected no strength for a lowball hand, got %v", response["strength"])
+	}
+}
@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"poker-app/internal/poker"
+)
+
+// OutsRequest represents the request body for /api/outs
+type OutsRequest struct {
+	Game           string     `json:"game"` // holdem (default) or shortdeck
+	HoleCards      []string   `json:"holeCards"`
+	CommunityCards []string   `json:"communityCards"` // a flop or a turn
+	Opponents      [][]string `json:"opponents"`      // optional known opponent hole cards
+	Format         string     `json:"format"`         // card output style: glyph (default), ascii, suit-rank or unicode
+}
+
+// DrawResponse describes one draw and the cards that complete it
+type DrawResponse struct {
+	Type poker.DrawType `json:"type"`
+	Outs []string       `json:"outs"`
+}
+
+// OutResponse describes one out
+type OutResponse struct {
+	Card     string         `json:"card"`
+	HandRank poker.HandRank `json:"handRank"` // the hero's hand with the card on the board
+}
+
+// OutsResponse represents the response for /api/outs
+type OutsResponse struct {
+	Street           string         `json:"street"` // turn or river
+	HandRank         poker.HandRank `json:"handRank"`
+	Ahead            bool           `json:"ahead,omitempty"` // already beats every opponent given
+	Draws            []DrawResponse `json:"draws"`
+	Outs             []OutResponse  `json:"outs"`
+	OutCount         int            `json:"outCount"`
+	Unseen           int            `json:"unseen"`
+	Probability      float64        `json:"probability"`      // exact chance of an out by the river
+	RuleOfTwoAndFour float64        `json:"ruleOfTwoAndFour"` // the quick estimate of the same
+	Success          bool           `json:"success"`
+	Error            string         `json:"error,omitempty"`
+}
+
+// OutsHandler finds the hero's outs and draws on a flop or turn
+func OutsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req OutsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		sendError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	game, err := poker.ParseGame(req.Game)
+	if err != nil {
+		sendError(w, fmt.Sprintf("Invalid game: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	format, err := poker.ParseCardFormat(req.Format)
+	if err != nil {
+		sendError(w, fmt.Sprintf("Invalid format: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	holeCards, err := poker.ParseCards(req.HoleCards)
+	if err != nil {
+		sendError(w, fmt.Sprintf("Invalid hole cards: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	communityCards, err := poker.ParseCards(req.CommunityCards)
+	if err != nil {
+		sendError(w, fmt.Sprintf("Invalid community cards: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	opponents := make([][]poker.Card, len(req.Opponents))
+	for i, cards := range req.Opponents {
+		opponents[i], err = poker.ParseCards(cards)
+		if err != nil {
+			sendError(w, fmt.Sprintf("Invalid opponent %d hole cards: %v", i+1, err), http.StatusBadRequest)
+			return
+		}
+	}
+
+	analysis, err := game.Outs(holeCards, communityCards, opponents...)
+	if err != nil {
+		sendError(w, fmt.Sprintf("Error finding outs: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	response := OutsResponse{
+		Street:           analysis.Street,
+		HandRank:         analysis.Rank,
+		Ahead:            analysis.Ahead,
+		Draws:            make([]DrawResponse, len(analysis.Draws)),
+		Outs:             make([]OutResponse, len(analysis.Outs)),
+		OutCount:         len(analysis.Outs),
+		Unseen:           analysis.Unseen,
+		Probability:      analysis.Probability,
+		RuleOfTwoAndFour: analysis.RuleOfTwoAndFour,
+		Success:          true,
+	}
+	for i, draw := range analysis.Draws {
+		response.Draws[i] = DrawResponse{Type: draw.Type, Outs: poker.FormatCards(draw.Outs, format)}
+	}
+	for i, out := range analysis.Outs {
+		response.Outs[i] = OutResponse{Card: out.Card.Format(format), HandRank: out.Rank}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"poker-app/internal/poker"
+)
+
+// RunoutRequest represents the request body for /api/runouts
+type RunoutRequest struct {
+	Game           string     `json:"game"` // holdem (default), omaha, shortdeck, ...
+	HoleCards      []string   `json:"holeCards"`
+	Opponents      [][]string `json:"opponents"`      // each opponent's hole cards
+	CommunityCards []string   `json:"communityCards"` // a flop or a turn
+	Format         string     `json:"format"`         // card output style: glyph (default), ascii, suit-rank or unicode
+}
+
+// RunoutCardResponse describes the hero's position after one next card
+type RunoutCardResponse struct {
+	Card     string              `json:"card"`
+	Equity   float64             `json:"equity"`
+	Swing    float64             `json:"swing"` // change from the equity before the card
+	HandRank poker.HandRank      `json:"handRank"`
+	Outcome  poker.RunoutOutcome `json:"outcome"`
+}
+
+// RunoutResponse represents the response for /api/runouts
+type RunoutResponse struct {
+	Street  string               `json:"street"` // turn or river
+	Equity  float64              `json:"equity"` // the hero's equity before the next card
+	Good    []RunoutCardResponse `json:"good"`
+	Neutral []RunoutCardResponse `json:"neutral"`
+	Bad     []RunoutCardResponse `json:"bad"`
+	Success bool                 `json:"success"`
+	Error   string               `json:"error,omitempty"`
+}
+
+// RunoutHandler lists every possible next community card by how it changes
+// the hero's equity against known opponent hands
+func RunoutHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req RunoutRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		sendError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	game, err := poker.ParseGame(req.Game)
+	if err != nil {
+		sendError(w, fmt.Sprintf("Invalid game: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	format, err := poker.ParseCardFormat(req.Format)
+	if err != nil {
+		sendError(w, fmt.Sprintf("Invalid format: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	holeCards, err := poker.ParseCards(req.HoleCards)
+	if err != nil {
+		sendError(w, fmt.Sprintf("Invalid hole cards: %v", err), http.StatusBadRequest)
+		return
+	}
+	holes := [][]poker.Card{holeCards}
+	for i, cards := range req.Opponents {
+		hole, err := poker.ParseCards(cards)
+		if err != nil {
+			sendError(w, fmt.Sprintf("Invalid opponent %d hole cards: %v", i+1, err), http.StatusBadRequest)
+			return
+		}
+		holes = append(holes, hole)
+	}
+
+	communityCards, err := poker.ParseCards(req.CommunityCards)
+	if err != nil {
+		sendError(w, fmt.Sprintf("Invalid community cards: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	analysis, err := game.Runouts(communityCards, holes...)
+	if err != nil {
+		sendError(w, fmt.Sprintf("Error analyzing runouts: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	response := RunoutResponse{
+		Street:  analysis.Street,
+		Equity:  analysis.Equity,
+		Good:    runoutCardsResponse(analysis.Good, format),
+		Neutral: runoutCardsResponse(analysis.Neutral, format),
+		Bad:     runoutCardsResponse(analysis.Bad, format),
+		Success: true,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// runoutCardsResponse converts one group of runout cards, keeping empty
+// groups as empty lists
+func runoutCardsResponse(cards []poker.RunoutCard, format poker.CardFormat) []RunoutCardResponse {
+	response := make([]RunoutCardResponse, len(cards))
+	for i, card := range cards {
+		response[i] = RunoutCardResponse{
+			Card:     card.Card.Format(format),
+			Equity:   card.Equity,
+			Swing:    card.Swing,
+			HandRank: card.Rank,
+			Outcome:  card.Outcome,
+		}
+	}
+	return response
+}
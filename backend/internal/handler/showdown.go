@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"poker-app/internal/poker"
+)
+
+// ShowdownRequest represents the request body for /api/showdown
+type ShowdownRequest struct {
+	Game           string     `json:"game"` // holdem (default), omaha, shortdeck, ...
+	CommunityCards []string   `json:"communityCards"`
+	Players        [][]string `json:"players"` // each player's hole cards
+	Format         string     `json:"format"`  // card output style: glyph (default), ascii, suit-rank or unicode
+}
+
+// ShowdownHandResponse describes one player's hand at showdown
+type ShowdownHandResponse struct {
+	HandRank    poker.HandRank     `json:"handRank"`
+	Description string             `json:"description"`
+	Cards       []string           `json:"cards"`
+	Strength    poker.HandStrength `json:"strength,omitempty"` // left out for lowball hands
+	Low         *LowHandResponse   `json:"low,omitempty"`
+}
+
+// ShowdownResponse represents the response for /api/showdown. Player indices
+// refer to positions in the request's players list.
+type ShowdownResponse struct {
+	Players    []ShowdownHandResponse `json:"players"`
+	Ranking    [][]int                `json:"ranking"`              // tie groups, best hand first
+	Winners    []int                  `json:"winners"`              // best hand (the high hand in hi/lo games)
+	LowWinners []int                  `json:"lowWinners,omitempty"` // best qualifying low in hi/lo games
+	Shares     []float64              `json:"shares"`               // fraction of the pot each player wins
+	Success    bool                   `json:"success"`
+	Error      string                 `json:"error,omitempty"`
+}
+
+// ShowdownHandler ranks the hands of 2 to 10 players sharing one board
+func ShowdownHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req ShowdownRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		sendError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	game, err := poker.ParseGame(req.Game)
+	if err != nil {
+		sendError(w, fmt.Sprintf("Invalid game: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	format, err := poker.ParseCardFormat(req.Format)
+	if err != nil {
+		sendError(w, fmt.Sprintf("Invalid format: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	board, err := poker.ParseCards(req.CommunityCards)
+	if err != nil {
+		sendError(w, fmt.Sprintf("Invalid community cards: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	holes := make([][]poker.Card, len(req.Players))
+	for i, cards := range req.Players {
+		holes[i], err = poker.ParseCards(cards)
+		if err != nil {
+			sendError(w, fmt.Sprintf("Invalid player %d hole cards: %v", i+1, err), http.StatusBadRequest)
+			return
+		}
+	}
+
+	result, err := game.Showdown(board, holes...)
+	if err != nil {
+		sendError(w, fmt.Sprintf("Error evaluating showdown: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	response := ShowdownResponse{
+		Ranking:    result.Ranking,
+		Winners:    result.Winners,
+		LowWinners: result.LowWinners,
+		Shares:     result.Shares,
+		Success:    true,
+	}
+	for i, hand := range result.Hands {
+		player := ShowdownHandResponse{
+			HandRank:    hand.Rank,
+			Description: hand.Description,
+			Cards:       poker.FormatCards(hand.Cards, format),
+			Strength:    hand.Strength(),
+		}
+		if game.IsHiLo() {
+			player.Low = lowHandResponse(result.Lows[i], format)
+		}
+		response.Players = append(response.Players, player)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,247 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"poker-app/internal/poker"
+)
+
+// StudPlayerRequest holds one player's cards in a stud request
+type StudPlayerRequest struct {
+	DownCards []string `json:"downCards"`
+	UpCards   []string `json:"upCards"` // in the order dealt; the first is the door card
+}
+
+// StudEvaluateRequest represents the request body for /api/stud/evaluate
+type StudEvaluateRequest struct {
+	Game    string              `json:"game"` // stud (default), stud8 or razz
+	Players []StudPlayerRequest `json:"players"`
+	Format  string              `json:"format"` // card output style: glyph (default), ascii, suit-rank or unicode
+}
+
+// StudHandResponse describes one player's evaluated stud hand
+type StudHandResponse struct {
+	HandRank    poker.HandRank   `json:"handRank"`
+	Description string           `json:"description"`
+	Cards       []string         `json:"cards"`
+	Low         *LowHandResponse `json:"low,omitempty"`
+}
+
+// StudEvaluateResponse represents the response for /api/stud/evaluate.
+// Player indices refer to positions in the request's players list.
+type StudEvaluateResponse struct {
+	Players    []StudHandResponse `json:"players"`
+	Winners    []int              `json:"winners"`              // best hand (the high hand in stud8)
+	LowWinners []int              `json:"lowWinners,omitempty"` // best qualifying low in stud8
+	Shares     []float64          `json:"shares"`               // fraction of the pot each player wins
+	BringIn    *int               `json:"bringIn,omitempty"`    // set when every player has a door card
+	Success    bool               `json:"success"`
+	Error      string             `json:"error,omitempty"`
+}
+
+// StudEvaluateHandler evaluates every player's stud hand and decides the pot
+func StudEvaluateHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req StudEvaluateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		sendError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	game, err := parseStudGame(req.Game)
+	if err != nil {
+		sendError(w, fmt.Sprintf("Invalid game: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	format, err := poker.ParseCardFormat(req.Format)
+	if err != nil {
+		sendError(w, fmt.Sprintf("Invalid format: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	players, err := parseStudPlayers(req.Players)
+	if err != nil {
+		sendError(w, fmt.Sprintf("Invalid cards: %v", err), http.StatusBadRequest)
+		return
+	}
+	if len(players) < 2 || len(players) > 8 {
+		sendError(w, "Number of players must be between 2 and 8", http.StatusBadRequest)
+		return
+	}
+
+	var allCards []poker.Card
+	for _, player := range players {
+		allCards = append(allCards, player.Cards()...)
+	}
+	if poker.HasDuplicates(allCards) {
+		sendError(w, "Duplicate cards detected", http.StatusBadRequest)
+		return
+	}
+
+	response := StudEvaluateResponse{Success: true}
+	hands := make([]*poker.HiLoHand, len(players))
+	for i, player := range players {
+		if err := player.Validate(); err != nil {
+			sendError(w, fmt.Sprintf("Invalid player %d hand: %v", i+1, err), http.StatusBadRequest)
+			return
+		}
+		cards := player.Cards()
+		if len(cards) < 5 {
+			sendError(w, fmt.Sprintf("Player %d needs at least 5 cards", i+1), http.StatusBadRequest)
+			return
+		}
+
+		hand := &poker.HiLoHand{}
+		if game.IsHiLo() {
+			hand, err = game.EvaluateHiLo(cards, nil)
+		} else {
+			hand.High, err = game.Evaluate(cards, nil)
+		}
+		if err != nil {
+			sendError(w, fmt.Sprintf("Error evaluating player %d hand: %v", i+1, err), http.StatusBadRequest)
+			return
+		}
+		hands[i] = hand
+
+		result := StudHandResponse{
+			HandRank:    hand.High.Rank,
+			Description: hand.High.Description,
+			Cards:       poker.FormatCards(hand.High.Cards, format),
+		}
+		if game.IsHiLo() {
+			result.Low = lowHandResponse(hand.Low, format)
+		}
+		response.Players = append(response.Players, result)
+	}
+
+	pot := poker.SplitHiLoPot(hands)
+	response.Winners = pot.HighWinners
+	response.LowWinners = pot.LowWinners
+	response.Shares = pot.Shares
+	response.BringIn = studBringIn(game, players)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// StudProbabilityRequest represents the request body for /api/stud/probability
+type StudProbabilityRequest struct {
+	Game        string              `json:"game"` // stud (default), stud8 or razz
+	Players     []StudPlayerRequest `json:"players"`
+	DeadCards   []string            `json:"deadCards"` // up cards of folded players
+	Simulations int                 `json:"simulations"`
+}
+
+// StudProbabilityResponse represents the response for /api/stud/probability
+type StudProbabilityResponse struct {
+	Players     []poker.PlayerEquity `json:"players"`
+	Simulations int                  `json:"simulations"`
+	BringIn     *int                 `json:"bringIn,omitempty"`
+	Success     bool                 `json:"success"`
+	Error       string               `json:"error,omitempty"`
+}
+
+// StudProbabilityHandler calculates every stud player's equity
+func StudProbabilityHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req StudProbabilityRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		sendError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	game, err := parseStudGame(req.Game)
+	if err != nil {
+		sendError(w, fmt.Sprintf("Invalid game: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	players, err := parseStudPlayers(req.Players)
+	if err != nil {
+		sendError(w, fmt.Sprintf("Invalid cards: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	deadCards, err := poker.ParseCards(req.DeadCards)
+	if err != nil {
+		sendError(w, fmt.Sprintf("Invalid dead cards: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	result, err := poker.CalculateStudEquity(game, players, deadCards, req.Simulations)
+	if err != nil {
+		sendError(w, fmt.Sprintf("Error calculating probability: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	response := StudProbabilityResponse{
+		Players:     result.Players,
+		Simulations: result.Simulations,
+		BringIn:     studBringIn(game, players),
+		Success:     true,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// parseStudGame resolves the game of a stud request, defaulting to Seven
+// Card Stud
+func parseStudGame(name string) (poker.Game, error) {
+	if name == "" {
+		return poker.Stud, nil
+	}
+	game, err := poker.ParseGame(name)
+	if err != nil {
+		return "", err
+	}
+	if !game.IsStud() {
+		return "", fmt.Errorf("%s is not a stud game", game)
+	}
+	return game, nil
+}
+
+// parseStudPlayers parses the cards of every player in a stud request
+func parseStudPlayers(requests []StudPlayerRequest) ([]poker.StudHand, error) {
+	players := make([]poker.StudHand, len(requests))
+	for i, req := range requests {
+		down, err := poker.ParseCards(req.DownCards)
+		if err != nil {
+			return nil, fmt.Errorf("player %d down cards: %v", i+1, err)
+		}
+		up, err := poker.ParseCards(req.UpCards)
+		if err != nil {
+			return nil, fmt.Errorf("player %d up cards: %v", i+1, err)
+		}
+		players[i] = poker.StudHand{DownCards: down, UpCards: up}
+	}
+	return players, nil
+}
+
+// studBringIn returns the bring-in player when every player shows a door
+// card, or nil otherwise
+func studBringIn(game poker.Game, players []poker.StudHand) *int {
+	doorCards := make([]poker.Card, len(players))
+	for i, player := range players {
+		if len(player.UpCards) == 0 {
+			return nil
+		}
+		doorCards[i] = player.UpCards[0]
+	}
+	bringIn, err := game.BringIn(doorCards)
+	if err != nil {
+		return nil
+	}
+	return &bringIn
+}
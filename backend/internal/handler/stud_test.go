@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestStudHandlers_RejectIllegalHands(t *testing.T) {
+	// Five down cards: legal to neither stud endpoint
+	players := `"players":[
+		{"downCards":["AS","KS","QS","JS","TS"],"upCards":["9S","8S","7S","6S"]},
+		{"downCards":["2H","3H"],"upCards":["4H","5H","6H"]}]`
+
+	tests := []struct {
+		path    string
+		body    string
+		handler http.HandlerFunc
+	}{
+		{"/api/stud/evaluate", `{` + players + `}`, StudEvaluateHandler},
+		{"/api/stud/probability", `{"simulations":100,` + players + `}`, StudProbabilityHandler},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.body))
+		rec := httptest.NewRecorder()
+		tt.handler(rec, req)
+
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("%s: Expected status 400, got %d", tt.path, rec.Code)
+		}
+		if !strings.Contains(rec.Body.String(), "at most 3 down cards") {
+			t.Errorf("%s: Expected the down card limit in the error, got %s", tt.path, rec.Body.String())
+		}
+	}
+}
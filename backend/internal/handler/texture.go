@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"poker-app/internal/poker"
+)
+
+// TextureRequest represents the request body for /api/texture
+type TextureRequest struct {
+	Game           string   `json:"game"`           // holdem (default), shortdeck, ...
+	CommunityCards []string `json:"communityCards"` // a flop, turn or river
+}
+
+// TextureResponse represents the response for /api/texture
+type TextureResponse struct {
+	Paired        bool                      `json:"paired"`
+	TripsOnBoard  bool                      `json:"tripsOnBoard"`
+	Suits         poker.SuitTexture         `json:"suits"`
+	MaxSuited     int                       `json:"maxSuited"`
+	Connectedness int                       `json:"connectedness"`
+	Straights     []poker.PossibleStraight  `json:"straights"`
+	Flushes       []poker.PossibleFlush     `json:"flushes"`
+	Categories    []poker.CategoryFrequency `json:"categories"`
+	Nuts          string                    `json:"nuts"`
+	DrawFrequency float64                   `json:"drawFrequency"`
+	WetFrequency  float64                   `json:"wetFrequency"`
+	Wetness       poker.Wetness             `json:"wetness"`
+	Success       bool                      `json:"success"`
+	Error         string                    `json:"error,omitempty"`
+}
+
+// TextureHandler describes the texture of a board of 3 to 5 community cards
+func TextureHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req TextureRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		sendError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	game, err := poker.ParseGame(req.Game)
+	if err != nil {
+		sendError(w, fmt.Sprintf("Invalid game: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	communityCards, err := poker.ParseCards(req.CommunityCards)
+	if err != nil {
+		sendError(w, fmt.Sprintf("Invalid community cards: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	texture, err := game.AnalyzeBoard(communityCards)
+	if err != nil {
+		sendError(w, fmt.Sprintf("Error analyzing board: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	response := TextureResponse{
+		Paired:        texture.Paired,
+		TripsOnBoard:  texture.TripsOnBoard,
+		Suits:         texture.Suits,
+		MaxSuited:     texture.MaxSuited,
+		Connectedness: texture.Connectedness,
+		Straights:     texture.Straights,
+		Flushes:       texture.Flushes,
+		Categories:    texture.Categories,
+		Nuts:          texture.Nuts,
+		DrawFrequency: texture.DrawFrequency,
+		WetFrequency:  texture.WetFrequency,
+		Wetness:       texture.Wetness,
+		Success:       true,
+	}
+	// Keep empty groups as empty lists
+	if response.Straights == nil {
+		response.Straights = []poker.PossibleStraight{}
+	}
+	if response.Flushes == nil {
+		response.Flushes = []poker.PossibleFlush{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,80 @@
+package poker
+
+// CardSource tells whether a card in a hand came from the player's hole
+// cards or from the board
+type CardSource string
+
+const (
+	FromHole  CardSource = "hole"
+	FromBoard CardSource = "board"
+)
+
+// CardRole tells whether a card is part of the made hand or only a kicker
+type CardRole string
+
+const (
+	Core   CardRole = "core"   // makes the hand: the pair, the set, every card of a straight
+	Kicker CardRole = "kicker" // only breaks ties between equal made hands
+)
+
+// HandCard is one card of a hand's best five with its source and role
+type HandCard struct {
+	Card   Card
+	Source CardSource
+	Role   CardRole
+}
+
+// Roles returns the role of each of the hand's cards, in the order of Cards.
+// Straights, flushes, full houses and low hands use all five cards; a high
+// card hand is made by its top card alone.
+func (h *Hand) Roles() []CardRole {
+	roles := make([]CardRole, len(h.Cards))
+	switch h.Rank {
+	case Straight, Flush, FullHouse, StraightFlush, RoyalFlush:
+		for i := range roles {
+			roles[i] = Core
+		}
+		return roles
+	case HighCard:
+		for i, card := range h.Cards {
+			roles[i] = Kicker
+			if h.low || (len(h.RankDetail) > 0 && card.Rank == h.RankDetail[0]) {
+				roles[i] = Core
+			}
+		}
+		return roles
+	}
+
+	counts := make(map[int]int)
+	for _, card := range h.Cards {
+		counts[card.Rank]++
+	}
+	for i, card := range h.Cards {
+		roles[i] = Kicker
+		if counts[card.Rank] > 1 {
+			roles[i] = Core
+		}
+	}
+	return roles
+}
+
+// Attribute labels each of the hand's cards with its role and with whether
+// it is one of the player's hole cards or a board card
+func (h *Hand) Attribute(holeCards []Card) []HandCard {
+	hole := NewCardSet(holeCards...)
+	roles := h.Roles()
+	cards := make([]HandCard, len(h.Cards))
+	for i, card := range h.Cards {
+		cards[i] = HandCard{Card: card, Source: FromBoard, Role: roles[i]}
+		if hole.Contains(card) {
+			cards[i].Source = FromHole
+		}
+	}
+	return cards
+}
+
+// PlaysBoard reports whether none of the player's hole cards are in the
+// hand, so the player's hand is the board itself
+func (h *Hand) PlaysBoard(holeCards []Card) bool {
+	return NewCardSet(h.Cards...).Intersect(NewCardSet(holeCards...)) == 0
+}
@@ -0,0 +1,91 @@
+package poker
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHandAttribute(t *testing.T) {
+	hole, _ := ParseCards([]string{"HA", "DK"})
+	board, _ := ParseCards([]string{"SA", "C9", "D7", "H3", "S2"})
+
+	hand, err := Holdem.Evaluate(hole, board)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	got := hand.Attribute(hole)
+	expected := []HandCard{
+		{Card{Rank: 14, Suit: "S"}, FromBoard, Core},
+		{Card{Rank: 14, Suit: "H"}, FromHole, Core},
+		{Card{Rank: 13, Suit: "D"}, FromHole, Kicker},
+		{Card{Rank: 9, Suit: "C"}, FromBoard, Kicker},
+		{Card{Rank: 7, Suit: "D"}, FromBoard, Kicker},
+	}
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %d cards, got %d", len(expected), len(got))
+	}
+	for _, want := range expected {
+		found := false
+		for _, card := range got {
+			if reflect.DeepEqual(card, want) {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("Expected %+v in %+v", want, got)
+		}
+	}
+	if hand.PlaysBoard(hole) {
+		t.Error("Expected the hand not to play the board")
+	}
+}
+
+func TestHandRoles(t *testing.T) {
+	tests := []struct {
+		name  string
+		cards []string
+		core  int
+	}{
+		{"High card", []string{"HA", "DK", "S9", "C7", "H3"}, 1},
+		{"Two pair", []string{"HA", "DA", "S9", "C9", "H3"}, 4},
+		{"Four of a kind", []string{"HA", "DA", "SA", "CA", "H3"}, 4},
+		{"Straight", []string{"HA", "D2", "S3", "C4", "H5"}, 5},
+		{"Full house", []string{"HA", "DA", "SA", "C9", "H9"}, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cards, _ := ParseCards(tt.cards)
+			hand, _ := EvaluateHand(cards)
+			core := 0
+			for _, role := range hand.Roles() {
+				if role == Core {
+					core++
+				}
+			}
+			if core != tt.core {
+				t.Errorf("Expected %d core cards, got %d", tt.core, core)
+			}
+		})
+	}
+}
+
+func TestHandPlaysBoard(t *testing.T) {
+	// The hole ace duplicates the board's broadway straight
+	hole, _ := ParseCards([]string{"HA", "D2"})
+	board, _ := ParseCards([]string{"SA", "SK", "DQ", "CJ", "HT"})
+
+	hand, err := Holdem.Evaluate(hole, board)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !hand.PlaysBoard(hole) {
+		t.Errorf("Expected the hand to play the board, got %v", hand.Cards)
+	}
+	for _, card := range hand.Attribute(hole) {
+		if card.Source != FromBoard {
+			t.Errorf("Expected only board cards, got %+v", card)
+		}
+	}
+}
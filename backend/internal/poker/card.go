@@ -12,51 +12,37 @@ type Card struct {
 	Suit string // H, D, C, S
 }
 
-// ParseCard converts a string like "HA" to a Card
+// ParseCard converts a single card to a Card. It accepts the rank-first form
+// ("Ah", "10h", "A♠"), the suit-first form ("HA", "♠A") and Unicode playing
+// card characters, in either case.
 func ParseCard(s string) (Card, error) {
-	if len(s) != 2 {
+	runes := []rune(strings.TrimSpace(s))
+	if len(runes) == 0 {
 		return Card{}, fmt.Errorf("invalid card format: %s", s)
 	}
-
-	suit := strings.ToUpper(string(s[0]))
-	rankChar := strings.ToUpper(string(s[1]))
-
-	// Validate suit
-	if !strings.Contains("HDCS", suit) {
-		return Card{}, fmt.Errorf("invalid suit: %s", suit)
+	card, n, err := scanCard(runes)
+	if err != nil {
+		return Card{}, err
 	}
-
-	// Parse rank
-	var rank int
-	switch rankChar {
-	case "2", "3", "4", "5", "6", "7", "8", "9":
-		rank = int(rankChar[0] - '0')
-	case "T":
-		rank = 10
-	case "J":
-		rank = 11
-	case "Q":
-		rank = 12
-	case "K":
-		rank = 13
-	case "A":
-		rank = 14
-	default:
-		return Card{}, fmt.Errorf("invalid rank: %s", rankChar)
+	if n != len(runes) {
+		return Card{}, fmt.Errorf("invalid card format: %s", s)
 	}
-
-	return Card{Rank: rank, Suit: suit}, nil
+	return card, nil
 }
 
-// ParseCards parses a slice of card strings
+// ParseCards parses a slice of card strings. Each string may hold one card
+// or several, as accepted by ParseCardString.
 func ParseCards(cards []string) ([]Card, error) {
-	result := make([]Card, len(cards))
-	for i, cardStr := range cards {
-		card, err := ParseCard(cardStr)
+	result := make([]Card, 0, len(cards))
+	for _, cardStr := range cards {
+		parsed, err := ParseCardString(cardStr)
 		if err != nil {
 			return nil, err
 		}
-		result[i] = card
+		if len(parsed) == 0 {
+			return nil, fmt.Errorf("invalid card format: %s", cardStr)
+		}
+		result = append(result, parsed...)
 	}
 	return result, nil
 }
@@ -0,0 +1,134 @@
+package poker
+
+import (
+	"math/bits"
+	"strings"
+)
+
+// suitOrder lists the suits in CardID order (clubs, diamonds, hearts, spades)
+const suitOrder = "CDHS"
+
+// CardID is a compact card index in the range 0-51.
+// Bits 0-12 of a suit's block hold ranks 2 through Ace, and the suits follow
+// suitOrder, so id = suitIndex*13 + (rank-2).
+type CardID uint8
+
+// NumCards is the number of cards in a standard deck
+const NumCards = 52
+
+// ID returns the compact index of the card. The card must be valid.
+func (c Card) ID() CardID {
+	return CardID(strings.Index(suitOrder, c.Suit)*13 + c.Rank - 2)
+}
+
+// Card converts the index back to a Card
+func (id CardID) Card() Card {
+	return Card{Rank: int(id%13) + 2, Suit: string(suitOrder[id/13])}
+}
+
+// Rank returns the rank (2-14) of the card with this index
+func (id CardID) Rank() int {
+	return int(id%13) + 2
+}
+
+// Suit returns the suit index (0-3, in suitOrder) of the card with this index
+func (id CardID) Suit() int {
+	return int(id / 13)
+}
+
+// CardSet is a set of cards stored as a 52-bit mask, one bit per CardID
+type CardSet uint64
+
+// FullDeck contains all 52 cards
+const FullDeck CardSet = 1<<NumCards - 1
+
+// NewCardSet builds a set from the given cards. Duplicates collapse into one
+// bit, so callers that need to detect them should compare Count with len(cards).
+func NewCardSet(cards ...Card) CardSet {
+	var cs CardSet
+	for _, card := range cards {
+		cs |= cardBit(card.ID())
+	}
+	return cs
+}
+
+// HasDuplicates reports whether any card appears more than once
+func HasDuplicates(cards []Card) bool {
+	var cs CardSet
+	for _, card := range cards {
+		bit := cardBit(card.ID())
+		if cs&bit != 0 {
+			return true
+		}
+		cs |= bit
+	}
+	return false
+}
+
+// cardBit returns the single-card set for id
+func cardBit(id CardID) CardSet {
+	return 1 << id
+}
+
+// Add returns the set with id added
+func (cs CardSet) Add(id CardID) CardSet {
+	return cs | cardBit(id)
+}
+
+// Contains reports whether the card is in the set
+func (cs CardSet) Contains(card Card) bool {
+	return cs&cardBit(card.ID()) != 0
+}
+
+// Has reports whether id is in the set
+func (cs CardSet) Has(id CardID) bool {
+	return cs&cardBit(id) != 0
+}
+
+// Union returns the cards in either set
+func (cs CardSet) Union(other CardSet) CardSet {
+	return cs | other
+}
+
+// Intersect returns the cards in both sets
+func (cs CardSet) Intersect(other CardSet) CardSet {
+	return cs & other
+}
+
+// Remove returns the cards in cs that are not in other
+func (cs CardSet) Remove(other CardSet) CardSet {
+	return cs &^ other
+}
+
+// Count returns the number of cards in the set
+func (cs CardSet) Count() int {
+	return bits.OnesCount64(uint64(cs))
+}
+
+// IDs returns the card indices in ascending order
+func (cs CardSet) IDs() []CardID {
+	ids := make([]CardID, 0, cs.Count())
+	for rest := uint64(cs); rest != 0; rest &= rest - 1 {
+		ids = append(ids, CardID(bits.TrailingZeros64(rest)))
+	}
+	return ids
+}
+
+// Cards returns the cards in the set in CardID order
+func (cs CardSet) Cards() []Card {
+	cards := make([]Card, 0, cs.Count())
+	for rest := uint64(cs); rest != 0; rest &= rest - 1 {
+		cards = append(cards, CardID(bits.TrailingZeros64(rest)).Card())
+	}
+	return cards
+}
+
+// suitMask returns the 13-bit rank mask of the cards of suit s
+func (cs CardSet) suitMask(s int) uint16 {
+	return uint16(cs>>(13*s)) & 0x1FFF
+}
+
+// rankMask returns the 13-bit mask of ranks present in any suit
+func (cs CardSet) rankMask() uint16 {
+	return cs.suitMask(0) | cs.suitMask(1) | cs.suitMask(2) | cs.suitMask(3)
+}
@@ -0,0 +1,66 @@
+package poker
+
+import (
+	"testing"
+)
+
+func TestCardIDRoundTrip(t *testing.T) {
+	seen := make(map[CardID]bool)
+	for _, suit := range []string{"H", "D", "C", "S"} {
+		for rank := 2; rank <= 14; rank++ {
+			card := Card{Rank: rank, Suit: suit}
+			id := card.ID()
+			if id >= NumCards {
+				t.Fatalf("ID out of range for %+v: %d", card, id)
+			}
+			if seen[id] {
+				t.Fatalf("Duplicate ID %d for %+v", id, card)
+			}
+			seen[id] = true
+			if back := id.Card(); back != card {
+				t.Errorf("Round trip failed: %+v -> %d -> %+v", card, id, back)
+			}
+		}
+	}
+}
+
+func TestCardSetOperations(t *testing.T) {
+	a, _ := ParseCards([]string{"HA", "HK", "S2"})
+	b, _ := ParseCards([]string{"HK", "D9"})
+	setA := NewCardSet(a...)
+	setB := NewCardSet(b...)
+
+	if setA.Count() != 3 {
+		t.Errorf("Expected 3 cards, got %d", setA.Count())
+	}
+	if got := setA.Union(setB).Count(); got != 4 {
+		t.Errorf("Expected union of 4 cards, got %d", got)
+	}
+	if got := setA.Intersect(setB); got != NewCardSet(Card{Rank: 13, Suit: "H"}) {
+		t.Errorf("Expected intersection {HK}, got %v", got.Cards())
+	}
+	rest := setA.Remove(setB)
+	if rest.Contains(Card{Rank: 13, Suit: "H"}) || !rest.Contains(Card{Rank: 14, Suit: "H"}) {
+		t.Errorf("Unexpected remove result %v", rest.Cards())
+	}
+	if got := len(setA.Cards()); got != 3 {
+		t.Errorf("Expected 3 cards back, got %d", got)
+	}
+	if FullDeck.Count() != NumCards {
+		t.Errorf("Expected full deck of %d cards, got %d", NumCards, FullDeck.Count())
+	}
+}
+
+func TestHasDuplicates(t *testing.T) {
+	unique, _ := ParseCards([]string{"HA", "SA", "DA"})
+	if HasDuplicates(unique) {
+		t.Error("Expected no duplicates")
+	}
+	dup, _ := ParseCards([]string{"HA", "SA", "HA"})
+	if !HasDuplicates(dup) {
+		t.Error("Expected duplicates to be detected")
+	}
+	if _, err := EvaluateHand(append(dup, unique[1:]...)); err == nil {
+		t.Error("Expected EvaluateHand to reject duplicate cards")
+	}
+}
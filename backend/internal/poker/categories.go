@@ -0,0 +1,76 @@
+package poker
+
+// CategoryOdds describes how often a hand finished with one category. The
+// win, tie and loss probabilities split that between the hero's results, so
+// for the opponents a loss is a hand the best opponent won.
+type CategoryOdds struct {
+	Rank            HandRank `json:"rank"`
+	Probability     float64  `json:"probability"`     // the hand finished with this category
+	WinProbability  float64  `json:"winProbability"`  // ... and the hero won
+	TieProbability  float64  `json:"tieProbability"`  // ... and the hero tied
+	LossProbability float64  `json:"lossProbability"` // ... and the hero lost
+}
+
+// CategoryBreakdown lists the categories the hero and the best opponent
+// finished with, strongest first under the game's rules, leaving out those
+// never made. In hi/lo games the categories are of the high hands.
+type CategoryBreakdown struct {
+	Hero      []CategoryOdds `json:"hero"`
+	Opponents []CategoryOdds `json:"opponents"` // the best hand among the opponents
+}
+
+// finalCategories are the categories the hero and the best opponent
+// finished one hand with
+type finalCategories struct {
+	hero, opponent HandRank
+}
+
+// categoryTally weighs the hands finished with each category by the hero's
+// result: index 0 for a win, 1 for a tie and 2 for a loss
+type categoryTally struct {
+	hero, opponent [RoyalFlush + 1][3]float64
+}
+
+// add records one hand the hero won (1), tied (0) or lost (-1)
+func (t *categoryTally) add(result int, categories finalCategories, weight float64) {
+	t.hero[categories.hero][1-result] += weight
+	t.opponent[categories.opponent][1-result] += weight
+}
+
+// merge adds another tally's hands to this one
+func (t *categoryTally) merge(other *categoryTally) {
+	for rank := range t.hero {
+		for i := range t.hero[rank] {
+			t.hero[rank][i] += other.hero[rank][i]
+			t.opponent[rank][i] += other.opponent[rank][i]
+		}
+	}
+}
+
+// breakdown converts the tally into probabilities over total weight, listing
+// the categories strongest first under the game's rules
+func (t *categoryTally) breakdown(game Game, total float64) *CategoryBreakdown {
+	return &CategoryBreakdown{
+		Hero:      categoryOdds(game, &t.hero, total),
+		Opponents: categoryOdds(game, &t.opponent, total),
+	}
+}
+
+// categoryOdds lists the categories made, strongest first
+func categoryOdds(game Game, counts *[RoyalFlush + 1][3]float64, total float64) []CategoryOdds {
+	odds := []CategoryOdds{}
+	for _, rank := range game.categories() {
+		c := counts[rank]
+		if c[0]+c[1]+c[2] == 0 {
+			continue
+		}
+		odds = append(odds, CategoryOdds{
+			Rank:            rank,
+			Probability:     (c[0] + c[1] + c[2]) / total,
+			WinProbability:  c[0] / total,
+			TieProbability:  c[1] / total,
+			LossProbability: c[2] / total,
+		})
+	}
+	return odds
+}
@@ -0,0 +1,84 @@
+package poker
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCalculateWinProbability_Categories(t *testing.T) {
+	// Heads-up with a flush draw on the turn: nine of the 46 rivers are hearts
+	hole, _ := ParseCards([]string{"HA", "HK"})
+	board, _ := ParseCards([]string{"H2", "H7", "C9", "ST"})
+	result, err := CalculateWinProbability(hole, board, 2, 0, WithMode(ExactMode))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var flush *CategoryOdds
+	for i, odds := range result.Categories.Hero {
+		if odds.Rank == Flush {
+			flush = &result.Categories.Hero[i]
+		}
+	}
+	if flush == nil || math.Abs(flush.Probability-9.0/46) > 1e-9 {
+		t.Fatalf("Expected the hero to make a flush 9/46 of the time, got %+v", result.Categories.Hero)
+	}
+	if flush.WinProbability <= 0.9*flush.Probability {
+		t.Errorf("Expected the nut flush to win almost always, got %+v", flush)
+	}
+}
+
+func TestCalculateWinProbability_CategoriesAddUp(t *testing.T) {
+	hole, _ := ParseCards([]string{"S9", "S8"})
+	for _, game := range []Game{Holdem, ShortDeck} {
+		result, err := CalculateWinProbability(hole, nil, 4, 5000, WithGame(game), WithSeed(11))
+		if err != nil {
+			t.Fatalf("%s: Unexpected error: %v", game, err)
+		}
+
+		position := map[HandRank]int{}
+		for i, rank := range game.categories() {
+			position[rank] = i
+		}
+		var made, wins, losses float64
+		for i, odds := range result.Categories.Hero {
+			if i > 0 && position[odds.Rank] <= position[result.Categories.Hero[i-1].Rank] {
+				t.Errorf("%s: Expected categories strongest first, got %+v", game, result.Categories.Hero)
+			}
+			made += odds.Probability
+			wins += odds.WinProbability
+		}
+		for _, odds := range result.Categories.Opponents {
+			losses += odds.LossProbability
+		}
+		if math.Abs(made-1) > 1e-9 || math.Abs(wins-result.WinProbability) > 1e-9 ||
+			math.Abs(losses-result.LossProbability) > 1e-9 {
+			t.Errorf("%s: Expected categories to add up to the result, got made %f, wins %f of %f, losses %f of %f",
+				game, made, wins, result.WinProbability, losses, result.LossProbability)
+		}
+	}
+}
+
+func TestGameCategories_ShortDeckOrder(t *testing.T) {
+	tests := []struct {
+		game Game
+		want []HandRank
+	}{
+		{Holdem, []HandRank{RoyalFlush, StraightFlush, FourOfAKind, FullHouse, Flush, Straight, ThreeOfAKind, TwoPair, OnePair, HighCard}},
+		{ShortDeck, []HandRank{RoyalFlush, StraightFlush, FourOfAKind, Flush, FullHouse, ThreeOfAKind, Straight, TwoPair, OnePair, HighCard}},
+		{ShortDeckClassic, []HandRank{RoyalFlush, StraightFlush, FourOfAKind, Flush, FullHouse, Straight, ThreeOfAKind, TwoPair, OnePair, HighCard}},
+	}
+
+	for _, tt := range tests {
+		got := tt.game.categories()
+		if len(got) != len(tt.want) {
+			t.Fatalf("%s: Expected %v, got %v", tt.game, tt.want, got)
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("%s: Expected %v, got %v", tt.game, tt.want, got)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,223 @@
+package poker
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MadeHand names what the hero's hole cards make with the board, relative
+// to the board's ranks
+type MadeHand string
+
+const (
+	NoMadeHand        MadeHand = "no made hand" // the hole cards pair nothing
+	Underpair         MadeHand = "underpair"    // a pocket pair below every board card
+	BottomPair        MadeHand = "bottom pair"  // a hole card pairs the lowest board card
+	MiddlePair        MadeHand = "middle pair"  // a pair between the top and bottom board cards
+	TopPair           MadeHand = "top pair"     // a hole card pairs the highest board card
+	Overpair          MadeHand = "overpair"     // a pocket pair above every board card
+	TwoPairMade       MadeHand = "two pair"     // both hole cards pair the board, or the hero's pair plays beside a board pair
+	TopTwoPair        MadeHand = "top two pair" // both hole cards pair the two highest board cards
+	Trips             MadeHand = "trips"        // one hole card matches a pair on the board
+	Set               MadeHand = "set"          // a pocket pair matches one board card
+	StraightMade      MadeHand = "straight"
+	FlushMade         MadeHand = "flush"
+	FullHouseMade     MadeHand = "full house"
+	Quads             MadeHand = "quads"
+	StraightFlushMade MadeHand = "straight flush"
+)
+
+// KickerStrength grades the side card of a top pair against the best
+// kickers the board leaves available
+type KickerStrength string
+
+const (
+	TopKicker  KickerStrength = "top kicker"  // the best kicker possible
+	GoodKicker KickerStrength = "good kicker" // one of the next three
+	WeakKicker KickerStrength = "weak kicker" // anything lower
+)
+
+// NutFlushDraw is a flush draw to the best possible flush
+const NutFlushDraw DrawType = "nut flush draw"
+
+// HandClass describes the hero's hand on a flop or turn in the words players
+// use: what it makes relative to the board and what it draws to
+type HandClass struct {
+	Made        MadeHand       `json:"made"`
+	Kicker      KickerStrength `json:"kicker,omitempty"` // set for top pair only
+	Draws       []DrawType     `json:"draws,omitempty"`
+	Combo       bool           `json:"combo"`       // a flush draw together with a straight draw
+	Description string         `json:"description"` // such as "top pair, good kicker with flush draw"
+}
+
+// Classify describes the hero's Hold'em hand on a flop or turn
+func Classify(holeCards, communityCards []Card) (*HandClass, error) {
+	return Holdem.Classify(holeCards, communityCards)
+}
+
+// Classify describes the hero's hand on a flop or turn in a game with two
+// hole cards
+func (g Game) Classify(holeCards, communityCards []Card) (*HandClass, error) {
+	if g.HoleCards() != 2 || !g.HasBoard() {
+		return nil, fmt.Errorf("classifying needs a game with two hole cards and a board, not %s", g)
+	}
+	if len(holeCards) != 2 {
+		return nil, fmt.Errorf("must have exactly 2 hole cards")
+	}
+	if len(communityCards) != 3 && len(communityCards) != 4 {
+		return nil, fmt.Errorf("classifying needs a flop or a turn, not %d community cards", len(communityCards))
+	}
+	hand, err := g.Evaluate(holeCards, communityCards)
+	if err != nil {
+		return nil, err
+	}
+
+	class := &HandClass{}
+	class.Made, class.Kicker = madeHand(g, hand, holeCards, communityCards)
+
+	known := NewCardSet(holeCards...) | NewCardSet(communityCards...)
+	flushDraw, straightDraw := false, false
+	for _, draw := range findDraws(g, holeCards, communityCards, hand, g.Deck().Remove(known)) {
+		switch draw.Type {
+		case FlushDraw:
+			flushDraw = true
+			if holdsNutFlushCard(holeCards, communityCards, draw.Outs[0].Suit) {
+				draw.Type = NutFlushDraw
+			}
+		case OpenEndedDraw, DoubleGutshot, Gutshot:
+			straightDraw = true
+		}
+		class.Draws = append(class.Draws, draw.Type)
+	}
+	class.Combo = flushDraw && straightDraw
+
+	class.Description = string(class.Made)
+	if class.Kicker != "" {
+		class.Description += ", " + string(class.Kicker)
+	}
+	if len(class.Draws) > 0 {
+		draws := make([]string, len(class.Draws))
+		for i, draw := range class.Draws {
+			draws[i] = string(draw)
+		}
+		last := len(draws) - 1
+		if last > 0 {
+			draws[last-1] += " and " + draws[last]
+			draws = draws[:last]
+		}
+		class.Description += " with " + strings.Join(draws, ", ")
+	}
+	if class.Combo {
+		class.Description += " (combo draw)"
+	}
+	return class, nil
+}
+
+// madeHand names what the hole cards make with the board, and grades the
+// kicker of a top pair
+func madeHand(g Game, hand *Hand, hole, board []Card) (MadeHand, KickerStrength) {
+	switch hand.Rank {
+	case StraightFlush, RoyalFlush:
+		return StraightFlushMade, ""
+	case FourOfAKind:
+		return Quads, ""
+	case FullHouse:
+		return FullHouseMade, ""
+	case Flush:
+		return FlushMade, ""
+	case Straight:
+		return StraightMade, ""
+	}
+
+	boardCounts := NewCardSet(board...).rankCounts()
+	count := func(rank int) uint8 { return boardCounts[rank-2] }
+	var boardRanks []int // distinct, highest first
+	for rank := 14; rank >= 2; rank-- {
+		if count(rank) > 0 {
+			boardRanks = append(boardRanks, rank)
+		}
+	}
+	top, bottom := boardRanks[0], boardRanks[len(boardRanks)-1]
+
+	// A pair of the hero's own that plays beside a pair on the board
+	playsWithBoardPair := func(rank int) bool {
+		return hand.Rank == TwoPair && (hand.RankDetail[0] == rank || hand.RankDetail[1] == rank)
+	}
+
+	high, low := hole[0].Rank, hole[1].Rank
+	if low > high {
+		high, low = low, high
+	}
+	if high == low {
+		switch {
+		case count(high) == 1:
+			return Set, ""
+		case playsWithBoardPair(high):
+			return TwoPairMade, ""
+		case high > top:
+			return Overpair, ""
+		case high < bottom:
+			return Underpair, ""
+		}
+		return MiddlePair, ""
+	}
+	if count(high) == 2 || count(low) == 2 {
+		return Trips, ""
+	}
+
+	pair, kicker := high, low
+	switch {
+	case count(high) > 0 && count(low) > 0:
+		if len(boardRanks) > 1 && high == boardRanks[0] && low == boardRanks[1] {
+			return TopTwoPair, ""
+		}
+		return TwoPairMade, ""
+	case count(low) > 0:
+		pair, kicker = low, high
+	case count(high) == 0:
+		return NoMadeHand, ""
+	}
+	if playsWithBoardPair(pair) {
+		return TwoPairMade, ""
+	}
+
+	switch pair {
+	case top:
+		return TopPair, kickerStrength(g, pair, kicker, boardCounts)
+	case bottom:
+		return BottomPair, ""
+	}
+	return MiddlePair, ""
+}
+
+// kickerStrength grades the kicker of a top pair by its place among the
+// ranks that are neither on the board nor the pair itself
+func kickerStrength(g Game, pair, kicker int, boardCounts [13]uint8) KickerStrength {
+	better := 0
+	for rank := 14; rank > kicker; rank-- {
+		if rank != pair && boardCounts[rank-2] == 0 && rank >= g.lowestRank() {
+			better++
+		}
+	}
+	switch {
+	case better == 0:
+		return TopKicker
+	case better <= 3:
+		return GoodKicker
+	}
+	return WeakKicker
+}
+
+// holdsNutFlushCard reports whether the hole cards include the highest card
+// of the suit that is not on the board
+func holdsNutFlushCard(hole, board []Card, suit string) bool {
+	onBoard := NewCardSet(board...)
+	for rank := 14; rank >= 2; rank-- {
+		card := Card{Rank: rank, Suit: suit}
+		if onBoard.Contains(card) {
+			continue
+		}
+		return NewCardSet(hole...).Contains(card)
+	}
+	return false
+}
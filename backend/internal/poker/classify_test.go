@@ -0,0 +1,89 @@
+package poker
+
+import "testing"
+
+func TestClassify_MadeHands(t *testing.T) {
+	tests := []struct {
+		hole   []string
+		board  []string
+		made   MadeHand
+		kicker KickerStrength
+	}{
+		{[]string{"HA", "DK"}, []string{"CK", "S7", "D2"}, TopPair, TopKicker},
+		{[]string{"HK", "DQ"}, []string{"CK", "S7", "D2"}, TopPair, GoodKicker},
+		{[]string{"HK", "D5"}, []string{"CK", "S7", "D2"}, TopPair, WeakKicker},
+		{[]string{"HA", "DK"}, []string{"CA", "S7", "D2"}, TopPair, TopKicker},
+		{[]string{"SA", "DA"}, []string{"CK", "S7", "D2"}, Overpair, ""},
+		{[]string{"S4", "D4"}, []string{"CK", "S7", "D5"}, Underpair, ""},
+		{[]string{"S3", "D3"}, []string{"CK", "S7", "D2"}, MiddlePair, ""},
+		{[]string{"H8", "H7"}, []string{"CK", "S7", "D2"}, MiddlePair, ""},
+		{[]string{"H3", "H2"}, []string{"CK", "S7", "D2"}, BottomPair, ""},
+		{[]string{"HK", "H7"}, []string{"CK", "S7", "D2"}, TopTwoPair, ""},
+		{[]string{"HK", "H2"}, []string{"CK", "S7", "D2"}, TwoPairMade, ""},
+		{[]string{"S7", "D7"}, []string{"CK", "C7", "D2"}, Set, ""},
+		{[]string{"HA", "H7"}, []string{"C7", "S7", "D2"}, Trips, ""},
+		{[]string{"S7", "D7"}, []string{"HK", "CK", "S5"}, TwoPairMade, ""},
+		{[]string{"SA", "D5"}, []string{"HK", "CK", "S5"}, TwoPairMade, ""},
+		{[]string{"SA", "DQ"}, []string{"HK", "CK", "SQ"}, TwoPairMade, ""},
+		{[]string{"S3", "D3"}, []string{"HK", "CK", "S5", "D5"}, Underpair, ""},
+		{[]string{"H6", "D5"}, []string{"C4", "S3", "D2"}, StraightMade, ""},
+		{[]string{"HA", "HQ"}, []string{"C7", "S7", "D2"}, NoMadeHand, ""},
+		{[]string{"HA", "HQ"}, []string{"C7", "S9", "D2", "H5"}, NoMadeHand, ""},
+	}
+
+	for _, tt := range tests {
+		hole, _ := ParseCards(tt.hole)
+		board, _ := ParseCards(tt.board)
+		class, err := Classify(hole, board)
+		if err != nil {
+			t.Fatalf("%v on %v: Unexpected error: %v", tt.hole, tt.board, err)
+		}
+		if class.Made != tt.made || class.Kicker != tt.kicker {
+			t.Errorf("%v on %v: Expected %s %s, got %s %s", tt.hole, tt.board, tt.made, tt.kicker, class.Made, class.Kicker)
+		}
+	}
+}
+
+func TestClassify_Draws(t *testing.T) {
+	tests := []struct {
+		hole        []string
+		board       []string
+		draws       []DrawType
+		combo       bool
+		description string
+	}{
+		{[]string{"HJ", "HT"}, []string{"H9", "H8", "C2"}, []DrawType{FlushDraw, OpenEndedDraw, Overcards}, true,
+			"no made hand with flush draw, open-ended straight draw and overcards (combo draw)"},
+		{[]string{"HA", "H3"}, []string{"H9", "H8", "CK"}, []DrawType{NutFlushDraw}, false,
+			"no made hand with nut flush draw"},
+		{[]string{"HA", "HQ"}, []string{"CA", "H8", "H2"}, []DrawType{NutFlushDraw}, false,
+			"top pair, good kicker with nut flush draw"},
+		{[]string{"SA", "DK"}, []string{"CK", "S7", "D2", "H9"}, nil, false,
+			"top pair, top kicker"},
+	}
+
+	for _, tt := range tests {
+		hole, _ := ParseCards(tt.hole)
+		board, _ := ParseCards(tt.board)
+		class, err := Classify(hole, board)
+		if err != nil {
+			t.Fatalf("%v on %v: Unexpected error: %v", tt.hole, tt.board, err)
+		}
+		if len(class.Draws) != len(tt.draws) || class.Combo != tt.combo || class.Description != tt.description {
+			t.Errorf("%v on %v: Expected %v (combo %v) %q, got %v (combo %v) %q", tt.hole, tt.board,
+				tt.draws, tt.combo, tt.description, class.Draws, class.Combo, class.Description)
+			continue
+		}
+		for i := range tt.draws {
+			if class.Draws[i] != tt.draws[i] {
+				t.Errorf("%v on %v: Expected draws %v, got %v", tt.hole, tt.board, tt.draws, class.Draws)
+			}
+		}
+	}
+
+	board, _ := ParseCards([]string{"C7", "S9", "D2", "H5", "HT"})
+	hole, _ := ParseCards([]string{"HA", "HQ"})
+	if _, err := Classify(hole, board); err == nil {
+		t.Error("Expected an error on the river")
+	}
+}
@@ -0,0 +1,139 @@
+package poker
+
+import "math/bits"
+
+// DrawType names a hand that needs help from the board to become a flush or
+// a straight, or to pair up
+type DrawType string
+
+const (
+	FlushDraw            DrawType = "flush draw"               // four cards to a flush
+	OpenEndedDraw        DrawType = "open-ended straight draw" // four in a row, open at both ends
+	DoubleGutshot        DrawType = "double gutshot"           // two different ranks fill inside straights
+	Gutshot              DrawType = "gutshot"                  // one rank completes a straight
+	Overcards            DrawType = "overcards"                // both hole cards above the board, no pair
+	BackdoorFlushDraw    DrawType = "backdoor flush draw"      // three to a flush on the flop
+	BackdoorStraightDraw DrawType = "backdoor straight draw"   // three to a straight on the flop
+)
+
+// Draw is one draw the hero holds, with the unseen cards that complete it.
+// Backdoor draws need both the turn and the river, so they list no outs.
+type Draw struct {
+	Type DrawType
+	Outs []Card
+}
+
+// suitCards returns every card of suit s
+func suitCards(s int) CardSet {
+	return CardSet(0x1FFF) << (13 * s)
+}
+
+// rankCards returns every card of the given rank
+func rankCards(rank int) CardSet {
+	var cs CardSet
+	for s := 0; s < 4; s++ {
+		cs = cs.Add(CardID(s*13 + rank - 2))
+	}
+	return cs
+}
+
+// straightRanks returns a mask with bit r set for every rank r in cs. An
+// ace also sets the bit just below lowest, where it plays low.
+func straightRanks(cs CardSet, lowest int) uint16 {
+	ranks := cs.rankMask() << 2
+	if ranks&(1<<14) != 0 {
+		ranks |= 1 << (lowest - 1)
+	}
+	return ranks
+}
+
+// straightWindows returns the straightRanks masks of every straight in a
+// deck whose lowest rank is lowest, the wheel first
+func straightWindows(lowest int) []uint16 {
+	var windows []uint16
+	for low := lowest - 1; low <= 10; low++ {
+		windows = append(windows, uint16(0x1F)<<low)
+	}
+	return windows
+}
+
+// findDraws lists the draws the hero holds with two hole cards on a flop or
+// turn. Every draw uses at least one hole card; made is the hero's current
+// hand and unseen the cards still to come.
+func findDraws(g Game, hole, board []Card, made *Hand, unseen CardSet) []Draw {
+	holeSet, boardSet := NewCardSet(hole...), NewCardSet(board...)
+	all := holeSet | boardSet
+	flop := len(board) == 3
+	var draws []Draw
+
+	// Flush draws
+	for s := 0; s < 4; s++ {
+		if holeSet.suitMask(s) == 0 {
+			continue
+		}
+		switch bits.OnesCount16(all.suitMask(s)) {
+		case 4:
+			draws = append(draws, Draw{Type: FlushDraw, Outs: (unseen & suitCards(s)).Cards()})
+		case 3:
+			if flop {
+				draws = append(draws, Draw{Type: BackdoorFlushDraw})
+			}
+		}
+	}
+
+	// Straight draws: the ranks that fill a four-card window
+	lowest := g.lowestRank()
+	ranks, holeRanks := straightRanks(all, lowest), straightRanks(holeSet, lowest)
+	var filling uint16
+	made5, three := false, false
+	for _, window := range straightWindows(lowest) {
+		if window&holeRanks == 0 {
+			continue
+		}
+		switch bits.OnesCount16(ranks & window) {
+		case 5:
+			made5 = true
+		case 4:
+			filling |= window &^ ranks
+		case 3:
+			three = true
+		}
+	}
+	if !made5 && filling != 0 {
+		draw := Draw{Type: Gutshot}
+		switch {
+		case filling&(filling>>5) != 0:
+			draw.Type = OpenEndedDraw
+		case bits.OnesCount16(filling) > 1:
+			draw.Type = DoubleGutshot
+		}
+		var outs CardSet
+		for m := filling; m != 0; m &= m - 1 {
+			rank := bits.TrailingZeros16(m)
+			if rank < lowest {
+				rank = 14 // the ace playing low
+			}
+			outs |= rankCards(rank)
+		}
+		draw.Outs = (unseen & outs).Cards()
+		draws = append(draws, draw)
+	} else if !made5 && three && flop {
+		draws = append(draws, Draw{Type: BackdoorStraightDraw})
+	}
+
+	// Two overcards to an unpaired board
+	if made.Rank == HighCard && min(hole[0].Rank, hole[1].Rank) > maxRank(board) {
+		outs := rankCards(hole[0].Rank) | rankCards(hole[1].Rank)
+		draws = append(draws, Draw{Type: Overcards, Outs: (unseen & outs).Cards()})
+	}
+	return draws
+}
+
+// maxRank returns the highest rank among cards
+func maxRank(cards []Card) int {
+	high := 0
+	for _, card := range cards {
+		high = max(high, card.Rank)
+	}
+	return high
+}
@@ -0,0 +1,198 @@
+package poker
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// PlayerEquity is one player's result in an equity calculation
+type PlayerEquity struct {
+	WinProbability float64 `json:"winProbability"` // wins the whole pot alone
+	TieProbability float64 `json:"tieProbability"` // wins part of the pot
+	Equity         float64 `json:"equity"`         // average fraction of the pot won
+}
+
+// EquityResult holds every player's equity from a simulation
+type EquityResult struct {
+	Players     []PlayerEquity `json:"players"`
+	Simulations int            `json:"simulations"`
+	Exact       bool           `json:"exact"` // every deal was enumerated; Simulations counts them
+	Seed        int64          `json:"seed"`  // seed of the simulation's random stream, 0 when exact
+}
+
+// CalculateEquity calculates the equity of 2 to 10 players whose hole cards
+// are all known, with an optional partial board, by simulating or (with
+// WithMode) enumerating the rest of the board. Split pots count
+// fractionally towards each player's equity. WithGame selects the variant.
+func CalculateEquity(holeCards [][]Card, communityCards []Card, numSimulations int, opts ...ProbabilityOption) (*EquityResult, error) {
+	config := probabilityConfig{game: Holdem, mode: MonteCarloMode}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	game := config.game
+
+	if !game.HasBoard() {
+		return nil, fmt.Errorf("%s has no community cards", game)
+	}
+	if len(config.ranges) > 0 {
+		return nil, fmt.Errorf("every player's hole cards are known, so ranges do not apply")
+	}
+	if len(holeCards) < 2 || len(holeCards) > 10 {
+		return nil, fmt.Errorf("number of players must be between 2 and 10")
+	}
+	if len(communityCards) > 5 {
+		return nil, fmt.Errorf("cannot have more than 5 community cards")
+	}
+
+	known := append([]Card{}, communityCards...)
+	hands := make([][]CardID, len(holeCards))
+	for i, hole := range holeCards {
+		if len(hole) != game.HoleCards() {
+			return nil, fmt.Errorf("player %d must have exactly %d hole cards", i+1, game.HoleCards())
+		}
+		known = append(known, hole...)
+		hands[i] = make([]CardID, len(hole))
+		for j, card := range hole {
+			hands[i][j] = card.ID()
+		}
+	}
+	if HasDuplicates(known) {
+		return nil, fmt.Errorf("duplicate cards detected")
+	}
+	if NewCardSet(known...).Remove(game.Deck()) != 0 {
+		return nil, fmt.Errorf("cards must come from the %s deck", game)
+	}
+	deck := game.Deck().Remove(NewCardSet(known...)).IDs()
+
+	var board [5]CardID
+	for i, card := range communityCards {
+		board[i] = card.ID()
+	}
+
+	highs := make([]uint16, len(hands))
+	var lows []uint16
+	if game.IsHiLo() {
+		lows = make([]uint16, len(hands))
+	}
+	shares := make([]float64, len(hands))
+	tally := newEquityTally(len(hands))
+	score := func(board [5]CardID) {
+		for i, hand := range hands {
+			highs[i] = game.score(hand, board[:])
+			if lows != nil {
+				lows[i] = omahaLowScore(hand, board[:])
+			}
+		}
+		potShares(shares, highs, lows)
+		tally.add(shares)
+	}
+
+	exact, err := config.mode.useExact(binomial(len(deck), 5-len(communityCards)))
+	if err != nil {
+		return nil, err
+	}
+	if exact {
+		enumerateDeals(deck, nil, 0, 0, board, len(communityCards),
+			func(_ []CardID, board [5]CardID, _ float64) bool {
+				score(board)
+				return true
+			})
+		result := tally.result()
+		result.Exact = true
+		return result, nil
+	}
+	if numSimulations < 1 {
+		return nil, fmt.Errorf("number of simulations must be at least 1")
+	}
+
+	seed := config.runSeed()
+	rng := rand.New(rand.NewSource(seed))
+	for sim := 0; sim < numSimulations; sim++ {
+		dealDeck(deck, 5-len(communityCards), rng)
+		copy(board[len(communityCards):], deck)
+		score(board)
+	}
+
+	result := tally.result()
+	result.Seed = seed
+	return result, nil
+}
+
+// equityTally accumulates pot shares over simulated hands
+type equityTally struct {
+	wins   []int
+	ties   []int
+	shares []float64
+	hands  int
+}
+
+func newEquityTally(numPlayers int) *equityTally {
+	return &equityTally{
+		wins:   make([]int, numPlayers),
+		ties:   make([]int, numPlayers),
+		shares: make([]float64, numPlayers),
+	}
+}
+
+// add records one hand given each player's fraction of the pot
+func (t *equityTally) add(shares []float64) {
+	for i, share := range shares {
+		switch {
+		case share == 1:
+			t.wins[i]++
+		case share > 0:
+			t.ties[i]++
+		}
+		t.shares[i] += share
+	}
+	t.hands++
+}
+
+// result converts the tally into probabilities
+func (t *equityTally) result() *EquityResult {
+	result := &EquityResult{Players: make([]PlayerEquity, len(t.wins)), Simulations: t.hands}
+	if t.hands == 0 {
+		return result
+	}
+	total := float64(t.hands)
+	for i := range result.Players {
+		result.Players[i] = PlayerEquity{
+			WinProbability: float64(t.wins[i]) / total,
+			TieProbability: float64(t.ties[i]) / total,
+			Equity:         t.shares[i] / total,
+		}
+	}
+	return result
+}
+
+// potShares fills shares with each player's fraction of one pot. The best
+// high scores split the pot; when lows is non-nil and any low score is
+// non-zero, the best lows split half of it.
+func potShares(shares []float64, highs []uint16, lows []uint16) {
+	for i := range shares {
+		shares[i] = 0
+	}
+	highPot := 1.0
+	if lows != nil {
+		if best := maxScore(lows); best > 0 {
+			highPot = 0.5
+			splitScores(shares, lows, best, 0.5)
+		}
+	}
+	splitScores(shares, highs, maxScore(highs), highPot)
+}
+
+// splitScores divides pot between the players whose score equals best
+func splitScores(shares []float64, scores []uint16, best uint16, pot float64) {
+	count := 0
+	for _, s := range scores {
+		if s == best {
+			count++
+		}
+	}
+	for i, s := range scores {
+		if s == best {
+			shares[i] += pot / float64(count)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package poker
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCalculateEquity(t *testing.T) {
+	aces, _ := ParseCards([]string{"HA", "SA"})
+	kings, _ := ParseCards([]string{"HK", "SK"})
+	suited, _ := ParseCards([]string{"D7", "D6"})
+
+	result, err := CalculateEquity([][]Card{aces, kings, suited}, nil, 20000)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(result.Players) != 3 || result.Simulations != 20000 {
+		t.Fatalf("Unexpected result shape: %+v", result)
+	}
+
+	total := 0.0
+	for _, player := range result.Players {
+		total += player.Equity
+	}
+	if math.Abs(total-1) > 1e-9 {
+		t.Errorf("Expected equities to sum to 1, got %f", total)
+	}
+	if result.Players[0].Equity < 0.6 || result.Players[0].Equity > 0.7 {
+		t.Errorf("Expected aces to have about 65%% equity, got %.3f", result.Players[0].Equity)
+	}
+}
+
+func TestCalculateEquity_SplitPot(t *testing.T) {
+	p1, _ := ParseCards([]string{"S2", "H3"})
+	p2, _ := ParseCards([]string{"D2", "C3"})
+	board, _ := ParseCards([]string{"SA", "HK", "DQ", "CJ", "ST"})
+
+	result, err := CalculateEquity([][]Card{p1, p2}, board, 100)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for i, player := range result.Players {
+		if player.Equity != 0.5 || player.TieProbability != 1 || player.WinProbability != 0 {
+			t.Errorf("Expected player %d to split every pot, got %+v", i, player)
+		}
+	}
+}
+
+func TestCalculateEquity_HiLo(t *testing.T) {
+	p1, _ := ParseCards([]string{"HA", "H2", "DK", "C9"})
+	p2, _ := ParseCards([]string{"CT", "DT", "HQ", "HJ"})
+	board, _ := ParseCards([]string{"S3", "H4", "D8", "CK", "SK"})
+
+	result, err := CalculateEquity([][]Card{p1, p2}, board, 10, WithGame(Omaha8))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.Players[0].Equity != 1 {
+		t.Errorf("Expected player 1 to scoop, got %+v", result.Players[0])
+	}
+}
+
+func TestCalculateEquity_Validation(t *testing.T) {
+	aces, _ := ParseCards([]string{"HA", "SA"})
+	kings, _ := ParseCards([]string{"HK", "SK"})
+	dup, _ := ParseCards([]string{"HA", "DK"})
+
+	if _, err := CalculateEquity([][]Card{aces}, nil, 100); err == nil {
+		t.Error("Expected error for a single player")
+	}
+	if _, err := CalculateEquity([][]Card{aces, dup}, nil, 100); err == nil {
+		t.Error("Expected error for duplicate cards")
+	}
+	if _, err := CalculateEquity([][]Card{aces, kings}, nil, 100, WithGame(Omaha)); err == nil {
+		t.Error("Expected error for the wrong number of hole cards")
+	}
+	if _, err := CalculateEquity([][]Card{aces, kings}, nil, 100, WithGame(Stud)); err == nil {
+		t.Error("Expected error for a game without a board")
+	}
+}
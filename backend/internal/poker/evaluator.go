@@ -2,6 +2,7 @@ package poker
 
 import (
 	"fmt"
+	"math/bits"
 	"sort"
 )
 
@@ -47,15 +48,45 @@ type Hand struct {
 	RankDetail  []int // Detailed ranking for tie-breaking (e.g., [14, 13, 12] for A-K-Q high)
 	Cards       []Card
 	Description string
+
+	strength HandStrength   // equivalence class, 0 when not evaluated through the tables
+	low      bool           // low hand: the lower rank and detail wins
+	order    *categoryOrder // ranking of the categories, nil for the standard order
+}
+
+// categoryOrder gives the position of each HandRank under a ruleset whose
+// categories do not follow the HandRank constants, such as short deck
+type categoryOrder [RoyalFlush + 1]int
+
+// category returns the position of the hand's rank in its ruleset
+func (h *Hand) category() int {
+	if h.order != nil {
+		return h.order[h.Rank]
+	}
+	return int(h.Rank)
+}
+
+// IsLow reports whether the hand is a low hand, where lower cards win
+func (h *Hand) IsLow() bool {
+	return h.low
 }
 
-// Compare compares two hands. Returns 1 if h1 wins, -1 if h2 wins, 0 for tie
+// Compare compares two hands. Returns 1 if h1 wins, -1 if h2 wins, 0 for tie.
+// Low hands are compared with each other in reverse, so a lower hand wins.
 func (h1 *Hand) Compare(h2 *Hand) int {
+	if h1.low {
+		return -compareRanks(h1, h2)
+	}
+	return compareRanks(h1, h2)
+}
+
+// compareRanks orders two hands by rank and then rank detail
+func compareRanks(h1, h2 *Hand) int {
 	// Compare rank first
-	if h1.Rank > h2.Rank {
+	if h1.category() > h2.category() {
 		return 1
 	}
-	if h1.Rank < h2.Rank {
+	if h1.category() < h2.category() {
 		return -1
 	}
 
@@ -82,37 +113,35 @@ func EvaluateHand(cards []Card) (*Hand, error) {
 	if len(cards) < 5 {
 		return nil, fmt.Errorf("need at least 5 cards to evaluate a hand")
 	}
-
-	// Generate all 5-card combinations
-	var bestHand *Hand
-	combinations := generateCombinations(cards, 5)
-
-	for _, combo := range combinations {
-		hand := evaluateFiveCards(combo)
-		if bestHand == nil || hand.Compare(bestHand) > 0 {
-			bestHand = hand
-		}
+	if HasDuplicates(cards) {
+		return nil, fmt.Errorf("duplicate cards in hand")
 	}
 
-	return bestHand, nil
+	return standardTables.best(cards), nil
 }
 
 // evaluateFiveCards evaluates exactly 5 cards
 func evaluateFiveCards(cards []Card) *Hand {
+	return evaluateFive(cards, 2)
+}
+
+// evaluateFive evaluates exactly 5 cards from a deck whose lowest rank is
+// lowest; the ace also plays below it to make the lowest straight
+func evaluateFive(cards []Card, lowest int) *Hand {
 	sorted := SortCards(cards)
 
-	// Check for flush
-	isFlush := true
-	firstSuit := sorted[0].Suit
-	for _, card := range sorted {
-		if card.Suit != firstSuit {
-			isFlush = false
+	// Check for flush: all five cards share one suit's rank mask
+	set := NewCardSet(cards...)
+	isFlush := false
+	for s := 0; s < 4; s++ {
+		if bits.OnesCount16(set.suitMask(s)) == 5 {
+			isFlush = true
 			break
 		}
 	}
 
 	// Check for straight
-	isStraight, highCard := checkStraight(sorted)
+	isStraight, highCard := checkStraight(sorted, lowest)
 
 	// Royal Flush: A-K-Q-J-T of same suit
 	if isFlush && isStraight && highCard == 14 {
@@ -248,8 +277,9 @@ func evaluateFiveCards(cards []Card) *Hand {
 	}
 }
 
-// checkStraight checks if the sorted cards form a straight
-func checkStraight(sorted []Card) (bool, int) {
+// checkStraight checks if the sorted cards form a straight. The ace plays
+// low below lowest, the smallest rank in the deck.
+func checkStraight(sorted []Card, lowest int) (bool, int) {
 	// Regular straight check
 	isStraight := true
 	for i := 1; i < len(sorted); i++ {
@@ -262,40 +292,15 @@ func checkStraight(sorted []Card) (bool, int) {
 		return true, sorted[0].Rank
 	}
 
-	// Check for A-2-3-4-5 (wheel)
-	if sorted[0].Rank == 14 && sorted[1].Rank == 5 && sorted[2].Rank == 4 &&
-		sorted[3].Rank == 3 && sorted[4].Rank == 2 {
-		return true, 5 // High card is 5 in this case
+	// Check for the wheel: A-2-3-4-5, or A-6-7-8-9 in a short deck
+	if sorted[0].Rank == 14 && sorted[1].Rank == lowest+3 && sorted[2].Rank == lowest+2 &&
+		sorted[3].Rank == lowest+1 && sorted[4].Rank == lowest {
+		return true, lowest + 3 // High card is the top of the low run
 	}
 
 	return false, 0
 }
 
-// generateCombinations generates all k-combinations from cards
-func generateCombinations(cards []Card, k int) [][]Card {
-	var result [][]Card
-	var current []Card
-
-	var backtrack func(start int)
-	backtrack = func(start int) {
-		if len(current) == k {
-			combo := make([]Card, k)
-			copy(combo, current)
-			result = append(result, combo)
-			return
-		}
-
-		for i := start; i < len(cards); i++ {
-			current = append(current, cards[i])
-			backtrack(i + 1)
-			current = current[:len(current)-1]
-		}
-	}
-
-	backtrack(0)
-	return result
-}
-
 // rankToString converts a rank number to string
 func rankToString(rank int) string {
 	switch rank {
@@ -313,3 +318,13 @@ func rankToString(rank int) string {
 		return fmt.Sprintf("%d", rank)
 	}
 }
+
+// rankName spells out a rank as a word, counting 1 as a low ace
+func rankName(rank int) string {
+	names := []string{"", "Ace", "Two", "Three", "Four", "Five", "Six", "Seven",
+		"Eight", "Nine", "Ten", "Jack", "Queen", "King", "Ace"}
+	if rank >= 1 && rank < len(names) {
+		return names[rank]
+	}
+	return "Unknown"
+}
@@ -0,0 +1,175 @@
+package poker
+
+import (
+	"fmt"
+	"math/bits"
+	"strings"
+)
+
+// Mode chooses how an equity calculation covers the unknown cards
+type Mode string
+
+const (
+	MonteCarloMode Mode = "montecarlo" // deal random hands; the default
+	ExactMode      Mode = "exact"      // enumerate every possible deal
+	AutoMode       Mode = "auto"       // enumerate when there are at most ExactThreshold deals
+)
+
+// ExactThreshold is the largest number of deals AutoMode enumerates
+const ExactThreshold = 2_000_000
+
+// maxExactDeals is the largest number of deals ExactMode agrees to enumerate
+const maxExactDeals = 50_000_000
+
+// modeAliases maps accepted spellings to modes
+var modeAliases = map[string]Mode{
+	"":            MonteCarloMode,
+	"montecarlo":  MonteCarloMode,
+	"monte-carlo": MonteCarloMode,
+	"exact":       ExactMode,
+	"auto":        AutoMode,
+}
+
+// ParseMode converts a mode name such as "exact" to a Mode. An empty name
+// selects Monte Carlo simulation.
+func ParseMode(s string) (Mode, error) {
+	mode, ok := modeAliases[strings.ToLower(strings.TrimSpace(s))]
+	if !ok {
+		return "", fmt.Errorf("unknown mode: %s", s)
+	}
+	return mode, nil
+}
+
+// WithMode selects between Monte Carlo simulation and exact enumeration.
+// Exact results count every deal equally (or by range weight), report the
+// number of deals as Simulations and give the weighted win, tie and loss
+// counts in Counts, from which the probabilities are exact fractions.
+func WithMode(mode Mode) ProbabilityOption {
+	return func(c *probabilityConfig) {
+		c.mode = mode
+	}
+}
+
+// useExact decides whether to enumerate a calculation with size deals
+func (m Mode) useExact(size float64) (bool, error) {
+	switch m {
+	case ExactMode:
+		if size > maxExactDeals {
+			return false, fmt.Errorf("too many deals to enumerate exactly (about %.0f); use montecarlo", size)
+		}
+		return true, nil
+	case AutoMode:
+		return size <= ExactThreshold, nil
+	case MonteCarloMode:
+		return false, nil
+	}
+	return false, fmt.Errorf("unknown mode: %s", m)
+}
+
+// enumerationSize estimates the number of deals enumerateDeals visits:
+// the ranged opponents' combos (ignoring overlaps), the random opponents'
+// hole cards and the rest of the board
+func enumerationSize(available int, ranges *rangeSampler, numRandom, holeCount, boardCards int) float64 {
+	size := 1.0
+	if ranges != nil {
+		for _, combos := range ranges.combos {
+			size *= float64(len(combos))
+			available -= 2
+		}
+	}
+	for i := 0; i < numRandom; i++ {
+		size *= binomial(available, holeCount)
+		available -= holeCount
+	}
+	return size * binomial(available, boardCards)
+}
+
+// binomial returns n choose k
+func binomial(n, k int) float64 {
+	if k < 0 || k > n {
+		return 0
+	}
+	result := 1.0
+	for i := 0; i < k; i++ {
+		result = result * float64(n-i) / float64(i+1)
+	}
+	return result
+}
+
+// enumerateDeals calls visit with every way to deal the unknown cards from
+// deck: one combo to each ranged opponent, holeCount cards to each of
+// numRandom random opponents and the rest of the board after its first
+// known cards. Opponents' hole cards are passed back to back, ranged
+// opponents first, and each deal is weighted by the product of its range
+// combo weights. The enumeration stops when visit returns false.
+func enumerateDeals(deck []CardID, ranges *rangeSampler, numRandom, holeCount int, board [5]CardID, known int,
+	visit func(opponents []CardID, board [5]CardID, weight float64) bool) {
+	numRanged := 0
+	if ranges != nil {
+		numRanged = len(ranges.combos)
+	}
+	opponents := make([]CardID, 0, 2*numRanged+holeCount*numRandom)
+	available := make([][]CardID, numRandom+1)
+	for i := range available {
+		available[i] = make([]CardID, 0, len(deck))
+	}
+
+	// liveCards fills buf with the cards of deck not in used
+	liveCards := func(buf []CardID, used CardSet) []CardID {
+		buf = buf[:0]
+		for _, id := range deck {
+			if used&cardBit(id) == 0 {
+				buf = append(buf, id)
+			}
+		}
+		return buf
+	}
+
+	stopped := false
+	var deal func(level int, used CardSet, weight float64)
+	deal = func(level int, used CardSet, weight float64) {
+		switch {
+		case level < numRanged:
+			for j, combo := range ranges.combos[level] {
+				if stopped {
+					return
+				}
+				if combo&used != 0 {
+					continue
+				}
+				opponents = append(opponents, CardID(bits.TrailingZeros64(uint64(combo))),
+					CardID(63-bits.LeadingZeros64(uint64(combo))))
+				deal(level+1, used|combo, weight*ranges.weights[level][j])
+				opponents = opponents[:len(opponents)-2]
+			}
+
+		case level < numRanged+numRandom:
+			live := liveCards(available[level-numRanged], used)
+			forEachCombination(len(live), holeCount, func(idx []int) bool {
+				var hand CardSet
+				for _, i := range idx {
+					opponents = append(opponents, live[i])
+					hand |= cardBit(live[i])
+				}
+				deal(level+1, used|hand, weight)
+				opponents = opponents[:len(opponents)-holeCount]
+				return !stopped
+			})
+
+		default:
+			live := liveCards(available[numRandom], used)
+			if known == 5 {
+				stopped = !visit(opponents, board, weight)
+				return
+			}
+			forEachCombination(len(live), 5-known, func(idx []int) bool {
+				for k, i := range idx {
+					board[known+k] = live[i]
+				}
+				stopped = !visit(opponents, board, weight)
+				return !stopped
+			})
+		}
+	}
+	deal(0, 0, 1)
+}
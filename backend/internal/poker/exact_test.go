@@ -0,0 +1,127 @@
+package poker
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCalculateEquity_Exact(t *testing.T) {
+	aces, _ := ParseCards([]string{"HA", "SA"})
+	kings, _ := ParseCards([]string{"HK", "SK"})
+
+	result, err := CalculateEquity([][]Card{aces, kings}, nil, 0, WithMode(ExactMode))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.Exact || result.Simulations != 1712304 {
+		t.Errorf("Expected all 1712304 boards to be enumerated, got %d (exact %v)", result.Simulations, result.Exact)
+	}
+	if eq := result.Players[0].Equity; eq < 0.81 || eq > 0.83 {
+		t.Errorf("Expected aces to have about 82%% equity, got %.4f", eq)
+	}
+	if total := result.Players[0].Equity + result.Players[1].Equity; math.Abs(total-1) > 1e-9 {
+		t.Errorf("Expected equities to sum to 1, got %f", total)
+	}
+}
+
+func TestCalculateWinProbability_ExactMatchesBruteForce(t *testing.T) {
+	hole, _ := ParseCards([]string{"HA", "HK"})
+	board, _ := ParseCards([]string{"HQ", "H7", "DK", "C2", "S7"})
+
+	// Count every opponent hand by hand
+	var wins, ties, losses int
+	deck := FullDeck.Remove(NewCardSet(append(hole, board...)...)).Cards()
+	hero, _ := EvaluateHand(append(append([]Card{}, hole...), board...))
+	forEachCombination(len(deck), 2, func(idx []int) bool {
+		opponent, _ := EvaluateHand(append([]Card{deck[idx[0]], deck[idx[1]]}, board...))
+		switch hero.Compare(opponent) {
+		case 1:
+			wins++
+		case 0:
+			ties++
+		default:
+			losses++
+		}
+		return true
+	})
+
+	result, err := CalculateWinProbability(hole, board, 2, 0, WithMode(AutoMode))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.Exact || result.Simulations != 990 {
+		t.Fatalf("Expected 990 enumerated hands, got %d (exact %v)", result.Simulations, result.Exact)
+	}
+	total := float64(wins + ties + losses)
+	if math.Abs(result.WinProbability-float64(wins)/total) > 1e-12 ||
+		math.Abs(result.TieProbability-float64(ties)/total) > 1e-12 ||
+		math.Abs(result.LossProbability-float64(losses)/total) > 1e-12 {
+		t.Errorf("Expected %d/%d/%d of %v, got %+v", wins, ties, losses, total, result)
+	}
+	want := OutcomeCounts{Wins: float64(wins), Ties: float64(ties), Losses: float64(losses), Total: total}
+	if result.Counts == nil || *result.Counts != want {
+		t.Errorf("Expected counts %+v, got %+v", want, result.Counts)
+	}
+}
+
+func TestCalculateWinProbability_ExactWithRanges(t *testing.T) {
+	hole, _ := ParseCards([]string{"HA", "SA"})
+	board, _ := ParseCards([]string{"DK", "C8", "H2", "S5"})
+	kings, _ := ParseRange("KK, QQ:0.5")
+
+	result, err := CalculateWinProbability(hole, board, 2, 0, WithMode(ExactMode), WithOpponentRanges(kings))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// 3 combos of kings and 6 of queens, each with 44 rivers
+	if result.Simulations != 9*44 {
+		t.Errorf("Expected %d deals, got %d", 9*44, result.Simulations)
+	}
+	// Set of kings beats aces unless one of 2 aces comes, while queens need
+	// one of 2 queens; the queens count half against a total weight of 3 + 3
+	want := (3*42.0/44 + 6*0.5*2/44) / 6
+	if math.Abs(result.LossProbability-want) > 1e-12 {
+		t.Errorf("Expected a loss probability of %f, got %f", want, result.LossProbability)
+	}
+}
+
+func TestCalculateWinProbability_Modes(t *testing.T) {
+	hole, _ := ParseCards([]string{"HA", "SA"})
+
+	result, err := CalculateWinProbability(hole, nil, 2, 500, WithMode(AutoMode))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.Exact || result.Simulations != 500 {
+		t.Errorf("Expected auto to simulate a preflop hand, got %+v", result)
+	}
+
+	if _, err := CalculateWinProbability(hole, nil, 4, 500, WithMode(ExactMode)); err == nil {
+		t.Error("Expected error enumerating a four-way preflop hand")
+	}
+	if _, err := CalculateWinProbability(hole, nil, 2, 0, WithMode(MonteCarloMode)); err == nil {
+		t.Error("Expected error simulating no hands")
+	}
+	if _, err := ParseMode("guess"); err == nil {
+		t.Error("Expected error for an unknown mode")
+	}
+}
+
+func TestCalculateWinProbability_ExactHiLo(t *testing.T) {
+	hole, _ := ParseCards([]string{"HA", "H2", "DK", "C9"})
+	board, _ := ParseCards([]string{"S3", "H4", "D8", "CK", "SK"})
+
+	result, err := CalculateWinProbability(hole, board, 2, 0, WithGame(Omaha8), WithMode(ExactMode))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.Exact || result.HiLo == nil {
+		t.Fatalf("Expected an exact hi/lo result, got %+v", result)
+	}
+	hilo := result.HiLo
+	sum := hilo.ScoopProbability + hilo.HighOnlyProbability + hilo.LowOnlyProbability +
+		hilo.ThreeQuarterProbability + hilo.QuarteredProbability + hilo.SplitProbability
+	if math.Abs(sum-result.WinProbability-result.TieProbability) > 1e-9 {
+		t.Errorf("Hi/lo breakdown %+v does not add up to win and tie", hilo)
+	}
+}
@@ -0,0 +1,77 @@
+package poker
+
+import (
+	"fmt"
+)
+
+// Comparison explains the result of comparing two hands
+type Comparison struct {
+	Result      int    // as returned by Compare
+	Decider     string // "category", the tiebreaker that decided such as "pair" or "second kicker", or "tie"
+	Value1      int    // the deciding rank of the first hand, 0 when the category decided or on a tie
+	Value2      int    // the deciding rank of the second hand
+	Explanation string // such as "kicker decides: King vs Queen"
+}
+
+// Explain compares two hands like Compare and reports which tiebreaker
+// decided the result
+func (h1 *Hand) Explain(h2 *Hand) *Comparison {
+	c := &Comparison{Result: h1.Compare(h2)}
+	winner, loser := h1, h2
+	if c.Result < 0 {
+		winner, loser = h2, h1
+	}
+
+	if h1.category() != h2.category() {
+		c.Decider = "category"
+		c.Explanation = fmt.Sprintf("%s beats %s", winner.Description, loser.Description)
+		return c
+	}
+
+	labels := detailLabels(h1)
+	for i := 0; i < len(h1.RankDetail) && i < len(h2.RankDetail); i++ {
+		if h1.RankDetail[i] == h2.RankDetail[i] {
+			continue
+		}
+		c.Decider = "tiebreaker"
+		if i < len(labels) {
+			c.Decider = labels[i]
+		}
+		c.Value1, c.Value2 = h1.RankDetail[i], h2.RankDetail[i]
+		c.Explanation = fmt.Sprintf("%s decides: %s vs %s", c.Decider,
+			rankName(winner.RankDetail[i]), rankName(loser.RankDetail[i]))
+		return c
+	}
+
+	c.Decider = "tie"
+	c.Explanation = fmt.Sprintf("both hands are %s and no card breaks the tie, so the pot is split", h1.Description)
+	return c
+}
+
+// detailLabels names each position of a hand's RankDetail
+func detailLabels(h *Hand) []string {
+	kickers := []string{"kicker", "second kicker", "third kicker", "fourth kicker"}
+	switch h.Rank {
+	case HighCard:
+		if h.low {
+			return []string{"highest card", "second card", "third card", "fourth card", "lowest card"}
+		}
+		return append([]string{"high card"}, kickers...)
+	case OnePair:
+		return append([]string{"pair"}, kickers...)
+	case TwoPair:
+		return []string{"top pair", "bottom pair", "kicker"}
+	case ThreeOfAKind:
+		return append([]string{"three of a kind"}, kickers...)
+	case Straight, StraightFlush, RoyalFlush:
+		return []string{"top card of the straight"}
+	case Flush:
+		return []string{"highest flush card", "second flush card", "third flush card",
+			"fourth flush card", "lowest flush card"}
+	case FullHouse:
+		return []string{"three of a kind", "pair"}
+	case FourOfAKind:
+		return []string{"four of a kind", "kicker"}
+	}
+	return nil
+}
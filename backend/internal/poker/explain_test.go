@@ -0,0 +1,87 @@
+package poker
+
+import (
+	"testing"
+)
+
+func TestHandExplain(t *testing.T) {
+	tests := []struct {
+		name        string
+		hand1       []string
+		hand2       []string
+		result      int
+		decider     string
+		explanation string
+	}{
+		{
+			"Category",
+			[]string{"HA", "HK", "H9", "H7", "H2"},
+			[]string{"SA", "DA", "CA", "H3", "D4"},
+			1, "category",
+			"Flush, Ace high beats Three of a Kind, Aces",
+		},
+		{
+			"Kicker",
+			[]string{"HA", "SA", "DQ", "C9", "H7"},
+			[]string{"CA", "DA", "SK", "C8", "H6"},
+			-1, "kicker",
+			"kicker decides: King vs Queen",
+		},
+		{
+			"Second kicker",
+			[]string{"HA", "SA", "DK", "C9", "H7"},
+			[]string{"CA", "DA", "SK", "C8", "H6"},
+			1, "second kicker",
+			"second kicker decides: Nine vs Eight",
+		},
+		{
+			"Bottom pair",
+			[]string{"HA", "SA", "D9", "C9", "H7"},
+			[]string{"CA", "DA", "S8", "C8", "HK"},
+			1, "bottom pair",
+			"bottom pair decides: Nine vs Eight",
+		},
+		{
+			"Split",
+			[]string{"HA", "SK", "DQ", "CJ", "HT"},
+			[]string{"CA", "DK", "SQ", "HJ", "ST"},
+			0, "tie",
+			"both hands are Straight, Ace high and no card breaks the tie, so the pot is split",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cards1, _ := ParseCards(tt.hand1)
+			cards2, _ := ParseCards(tt.hand2)
+			hand1, _ := EvaluateHand(cards1)
+			hand2, _ := EvaluateHand(cards2)
+
+			c := hand1.Explain(hand2)
+			if c.Result != tt.result {
+				t.Errorf("Expected result %d, got %d", tt.result, c.Result)
+			}
+			if c.Decider != tt.decider {
+				t.Errorf("Expected decider %q, got %q", tt.decider, c.Decider)
+			}
+			if c.Explanation != tt.explanation {
+				t.Errorf("Expected %q, got %q", tt.explanation, c.Explanation)
+			}
+		})
+	}
+}
+
+func TestHandExplain_Low(t *testing.T) {
+	cards1, _ := ParseCards([]string{"HA", "S2", "D3", "C4", "H7"})
+	cards2, _ := ParseCards([]string{"CA", "D2", "S3", "H5", "C7"})
+	hand1, _ := EvaluateAceToFive(cards1)
+	hand2, _ := EvaluateAceToFive(cards2)
+
+	c := hand1.Explain(hand2)
+	if c.Result != 1 || c.Decider != "second card" {
+		t.Errorf("Expected the second card to decide for hand 1, got %+v", c)
+	}
+	if c.Explanation != "second card decides: Four vs Five" {
+		t.Errorf("Unexpected explanation %q", c.Explanation)
+	}
+}
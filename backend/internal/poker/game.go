@@ -0,0 +1,216 @@
+package poker
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Game identifies the poker variant a hand is played under
+type Game string
+
+const (
+	Holdem Game = "holdem" // Texas Hold'em: best five of two hole cards and the board
+	Omaha  Game = "omaha"  // Pot-Limit Omaha with four hole cards
+	Omaha5 Game = "omaha5" // Five-card Omaha
+	Omaha6 Game = "omaha6" // Six-card Omaha
+	Omaha8 Game = "omaha8" // Omaha hi/lo eight-or-better with four hole cards
+	Stud   Game = "stud"   // Seven Card Stud
+	Stud8  Game = "stud8"  // Seven Card Stud hi/lo eight-or-better
+
+	ShortDeck        Game = "shortdeck"         // Short deck (6+) Hold'em: flush beats full house, trips beat a straight
+	ShortDeckClassic Game = "shortdeck-classic" // Short deck Hold'em where a straight still beats trips
+
+	Razz         Game = "razz"           // Seven Card Stud played for the best ace-to-five low
+	DeuceToSeven Game = "deuce-to-seven" // Deuce-to-seven lowball with five cards, as in 2-7 Triple Draw
+)
+
+// gameAliases maps accepted spellings to games
+var gameAliases = map[string]Game{
+	"":        Holdem,
+	"holdem":  Holdem,
+	"hold'em": Holdem,
+	"texas":   Holdem,
+	"nlhe":    Holdem,
+	"omaha":   Omaha,
+	"omaha4":  Omaha,
+	"plo":     Omaha,
+	"plo4":    Omaha,
+	"omaha5":  Omaha5,
+	"plo5":    Omaha5,
+	"omaha6":  Omaha6,
+	"plo6":    Omaha6,
+	"omaha8":  Omaha8,
+	"plo8":    Omaha8,
+	"o8":      Omaha8,
+	"stud":    Stud,
+	"stud7":   Stud,
+	"stud8":   Stud8,
+
+	"shortdeck":         ShortDeck,
+	"short-deck":        ShortDeck,
+	"6plus":             ShortDeck,
+	"6+":                ShortDeck,
+	"shortdeck-classic": ShortDeckClassic,
+
+	"razz":            Razz,
+	"deuce-to-seven":  DeuceToSeven,
+	"2-7":             DeuceToSeven,
+	"27":              DeuceToSeven,
+	"2-7-triple-draw": DeuceToSeven,
+	"27td":            DeuceToSeven,
+}
+
+// ParseGame converts a game name such as "holdem" or "plo5" to a Game.
+// An empty name selects Hold'em.
+func ParseGame(s string) (Game, error) {
+	game, ok := gameAliases[strings.ToLower(strings.TrimSpace(s))]
+	if !ok {
+		return "", fmt.Errorf("unknown game: %s", s)
+	}
+	return game, nil
+}
+
+// HoleCards returns the number of hole cards each player is dealt
+func (g Game) HoleCards() int {
+	switch g {
+	case Omaha, Omaha8:
+		return 4
+	case Omaha5:
+		return 5
+	case Omaha6:
+		return 6
+	case Stud, Stud8, Razz:
+		return 7
+	case DeuceToSeven:
+		return 5
+	default:
+		return 2
+	}
+}
+
+// isOmaha reports whether hands must use exactly two hole cards
+func (g Game) isOmaha() bool {
+	return g == Omaha || g == Omaha5 || g == Omaha6 || g == Omaha8
+}
+
+// IsHiLo reports whether the pot is split between the best high hand and
+// the best qualifying low hand
+func (g Game) IsHiLo() bool {
+	return g == Omaha8 || g == Stud8
+}
+
+// IsLowball reports whether the lowest hand wins the whole pot
+func (g Game) IsLowball() bool {
+	return g == Razz || g == DeuceToSeven
+}
+
+// IsStud reports whether the game is a seven card stud game, where each
+// player has their own down and up cards and there is no board
+func (g Game) IsStud() bool {
+	return g == Stud || g == Stud8 || g == Razz
+}
+
+// HasBoard reports whether players share community cards
+func (g Game) HasBoard() bool {
+	return !g.IsStud() && g != DeuceToSeven
+}
+
+// Deck returns the cards the game is played with
+func (g Game) Deck() CardSet {
+	if g == ShortDeck || g == ShortDeckClassic {
+		return ShortDeckCards
+	}
+	return FullDeck
+}
+
+// lowestRank returns the smallest rank in the game's deck, above which the
+// ace also plays low in a straight
+func (g Game) lowestRank() int {
+	if g == ShortDeck || g == ShortDeckClassic {
+		return shortDeckLowest
+	}
+	return 2
+}
+
+// tables returns the lookup tables that rank the game's high hands
+func (g Game) tables() *rankTables {
+	switch g {
+	case ShortDeck:
+		return shortDeckTables
+	case ShortDeckClassic:
+		return shortDeckClassicTables
+	case Razz:
+		return razzTables
+	default:
+		return standardTables
+	}
+}
+
+// Evaluate evaluates a player's best hand from their hole cards and the
+// community cards under the rules of the game. When a hole card and a board
+// card are interchangeable the board card is used, so a hand that plays the
+// board holds only board cards.
+func (g Game) Evaluate(holeCards []Card, communityCards []Card) (*Hand, error) {
+	if g.isOmaha() {
+		if len(holeCards) != g.HoleCards() {
+			return nil, fmt.Errorf("%s needs exactly %d hole cards", g, g.HoleCards())
+		}
+		return EvaluateOmaha(holeCards, communityCards)
+	}
+
+	allCards := make([]Card, 0, len(holeCards)+len(communityCards))
+	allCards = append(allCards, communityCards...)
+	allCards = append(allCards, holeCards...)
+	switch g {
+	case Razz:
+		return EvaluateAceToFive(allCards)
+	case DeuceToSeven:
+		return EvaluateDeuceToSeven(allCards)
+	case ShortDeck, ShortDeckClassic:
+		return EvaluateShortDeck(allCards, g == ShortDeckClassic)
+	}
+	return EvaluateHand(allCards)
+}
+
+// category returns the HandRank of a table class from score
+func (g Game) category(class uint16) HandRank {
+	return g.tables().hands[class].Rank
+}
+
+// categories lists every HandRank from the strongest category to the
+// weakest under the game's rules
+func (g Game) categories() []HandRank {
+	ranks := make([]HandRank, 0, RoyalFlush+1)
+	for rank := RoyalFlush; rank >= HighCard; rank-- {
+		ranks = append(ranks, rank)
+	}
+	var order *categoryOrder
+	switch g {
+	case ShortDeck:
+		order = &shortDeckOrder
+	case ShortDeckClassic:
+		order = &shortDeckClassicOrder
+	default:
+		return ranks
+	}
+	sort.Slice(ranks, func(i, j int) bool { return order[ranks[i]] > order[ranks[j]] })
+	return ranks
+}
+
+// score returns the table class of a player's best hand against a complete
+// board, without allocating
+func (g Game) score(hole []CardID, board []CardID) uint16 {
+	if g.isOmaha() {
+		return omahaScore(standardTables, hole, board)
+	}
+	t := g.tables()
+	var cs CardSet
+	for _, id := range hole {
+		cs = cs.Add(id)
+	}
+	for _, id := range board {
+		cs = cs.Add(id)
+	}
+	return t.evaluate(cs)
+}
@@ -0,0 +1,227 @@
+package poker
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// HiLoHand is a player's hand in a hi/lo split game
+type HiLoHand struct {
+	High *Hand
+	Low  *Hand // nil when the player has no qualifying eight-or-better low
+}
+
+// SplitPot describes how a hi/lo pot is divided between players
+type SplitPot struct {
+	HighWinners []int     // indices of the players sharing the high half
+	LowWinners  []int     // indices of the players sharing the low half; empty when no low qualifies
+	Shares      []float64 // fraction of the whole pot won by each player
+	Scooper     int       // index of the player winning the whole pot, or -1
+}
+
+// lowValue returns the rank of a card in a low hand, where the ace is one
+func lowValue(rank int) int {
+	if rank == 14 {
+		return 1
+	}
+	return rank
+}
+
+// lowEightBit returns the bit for the card in an eight-or-better rank mask
+// (bit 0 is the ace, bit 7 the eight), or 0 if the card is too high to count
+func lowEightBit(rank int) uint8 {
+	if v := lowValue(rank); v <= 8 {
+		return 1 << (v - 1)
+	}
+	return 0
+}
+
+// lowEightMask returns the eight-or-better rank mask of a card set
+func lowEightMask(cs CardSet) uint8 {
+	ranks := cs.rankMask()
+	return uint8(ranks&0x7F)<<1 | uint8(ranks>>12&1)
+}
+
+// lowestFive keeps the five lowest ranks of an eight-or-better rank mask, or
+// returns 0 when it has fewer than five. Comparing the results as integers
+// orders lows: the smaller mask is the better low.
+func lowestFive(mask uint8) uint8 {
+	if bits.OnesCount8(mask) < 5 {
+		return 0
+	}
+	for bits.OnesCount8(mask) > 5 {
+		mask &^= 1 << (7 - bits.LeadingZeros8(mask))
+	}
+	return mask
+}
+
+// lowEightScore turns a low mask into a score where higher is better and
+// 0 means no qualifying low
+func lowEightScore(mask uint8) uint16 {
+	if mask == 0 {
+		return 0
+	}
+	return 256 - uint16(mask)
+}
+
+// EvaluateLowEight returns the best eight-or-better low from the given cards:
+// five distinct ranks of eight or lower, aces low, ignoring straights and
+// flushes. It returns nil when the cards do not make a qualifying low.
+func EvaluateLowEight(cards []Card) *Hand {
+	var mask uint8
+	for _, card := range cards {
+		mask |= lowEightBit(card.Rank)
+	}
+	mask = lowestFive(mask)
+	if mask == 0 {
+		return nil
+	}
+	return lowEightHand(mask, cards)
+}
+
+// EvaluateOmahaLow returns the best eight-or-better low using exactly two
+// hole cards and three community cards, or nil when there is none
+func EvaluateOmahaLow(holeCards []Card, communityCards []Card) *Hand {
+	var best uint8
+	var bestCards []Card
+	forEachCombination(len(holeCards), 2, func(hi []int) bool {
+		forEachCombination(len(communityCards), 3, func(bi []int) bool {
+			combo := []Card{holeCards[hi[0]], holeCards[hi[1]],
+				communityCards[bi[0]], communityCards[bi[1]], communityCards[bi[2]]}
+			var mask uint8
+			for _, card := range combo {
+				mask |= lowEightBit(card.Rank)
+			}
+			if bits.OnesCount8(mask) == 5 && (best == 0 || mask < best) {
+				best = mask
+				bestCards = combo
+			}
+			return true
+		})
+		return true
+	})
+	if best == 0 {
+		return nil
+	}
+	return lowEightHand(best, bestCards)
+}
+
+// lowEightHand builds the low Hand for a five-rank mask, taking the first
+// card of each rank from cards
+func lowEightHand(mask uint8, cards []Card) *Hand {
+	hand := &Hand{Rank: HighCard, low: true}
+	for v := 8; v >= 1; v-- {
+		if mask&(1<<(v-1)) == 0 {
+			continue
+		}
+		for _, card := range cards {
+			if lowValue(card.Rank) == v {
+				hand.Cards = append(hand.Cards, card)
+				break
+			}
+		}
+		hand.RankDetail = append(hand.RankDetail, v)
+	}
+	hand.Description = lowDescription(hand.RankDetail)
+	return hand
+}
+
+// omahaLowScore returns the best two-hole-card, three-board-card low score
+func omahaLowScore(hole []CardID, board []CardID) uint16 {
+	var best uint8
+	for i := 0; i < len(hole); i++ {
+		for j := i + 1; j < len(hole); j++ {
+			a, b := lowEightBit(hole[i].Rank()), lowEightBit(hole[j].Rank())
+			if a == 0 || b == 0 || a == b {
+				continue
+			}
+			pair := a | b
+			for x := 0; x < len(board); x++ {
+				for y := x + 1; y < len(board); y++ {
+					for z := y + 1; z < len(board); z++ {
+						mask := pair | lowEightBit(board[x].Rank()) | lowEightBit(board[y].Rank()) | lowEightBit(board[z].Rank())
+						if bits.OnesCount8(mask) == 5 && (best == 0 || mask < best) {
+							best = mask
+						}
+					}
+				}
+			}
+		}
+	}
+	return lowEightScore(best)
+}
+
+// EvaluateHiLo evaluates both halves of a hand in a hi/lo game
+func (g Game) EvaluateHiLo(holeCards []Card, communityCards []Card) (*HiLoHand, error) {
+	if !g.IsHiLo() {
+		return nil, fmt.Errorf("%s is not a hi/lo game", g)
+	}
+	high, err := g.Evaluate(holeCards, communityCards)
+	if err != nil {
+		return nil, err
+	}
+
+	if g.isOmaha() {
+		return &HiLoHand{High: high, Low: EvaluateOmahaLow(holeCards, communityCards)}, nil
+	}
+	allCards := append(append([]Card{}, holeCards...), communityCards...)
+	return &HiLoHand{High: high, Low: EvaluateLowEight(allCards)}, nil
+}
+
+// SplitHiLoPot divides a pot between hi/lo hands. The best high hands share
+// half the pot and the best qualifying lows share the other half; when no
+// hand qualifies for low, the high hands share the whole pot.
+func SplitHiLoPot(hands []*HiLoHand) *SplitPot {
+	highs := make([]*Hand, len(hands))
+	lows := make([]*Hand, len(hands))
+	for i, hand := range hands {
+		highs[i] = hand.High
+		lows[i] = hand.Low
+	}
+
+	pot := &SplitPot{
+		HighWinners: bestHands(highs),
+		LowWinners:  bestHands(lows),
+		Shares:      make([]float64, len(hands)),
+		Scooper:     -1,
+	}
+
+	highPot := 1.0
+	if len(pot.LowWinners) > 0 {
+		highPot = 0.5
+		for _, i := range pot.LowWinners {
+			pot.Shares[i] += 0.5 / float64(len(pot.LowWinners))
+		}
+	}
+	for _, i := range pot.HighWinners {
+		pot.Shares[i] += highPot / float64(len(pot.HighWinners))
+	}
+	for i, share := range pot.Shares {
+		if share == 1 {
+			pot.Scooper = i
+		}
+	}
+	return pot
+}
+
+// bestHands returns the indices of the hands that tie for best, in order,
+// ignoring nil hands
+func bestHands(hands []*Hand) []int {
+	var best []int
+	for i, hand := range hands {
+		if hand == nil {
+			continue
+		}
+		if len(best) == 0 {
+			best = []int{i}
+			continue
+		}
+		switch hand.Compare(hands[best[0]]) {
+		case 1:
+			best = []int{i}
+		case 0:
+			best = append(best, i)
+		}
+	}
+	return best
+}
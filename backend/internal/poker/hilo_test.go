@@ -0,0 +1,185 @@
+package poker
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestEvaluateLowEight(t *testing.T) {
+	tests := []struct {
+		name        string
+		cards       []string
+		detail      []int
+		description string
+	}{
+		{"Wheel with pairs", []string{"SA", "H2", "D3", "C4", "S5", "HK", "DK"}, []int{5, 4, 3, 2, 1}, "Five-Four low"},
+		{"Rough eight", []string{"S8", "H7", "D6", "C5", "S4", "H4"}, []int{8, 7, 6, 5, 4}, "Eight-Seven low"},
+		{"Best five of six low ranks", []string{"S8", "H2", "D6", "C3", "S4", "HA"}, []int{6, 4, 3, 2, 1}, "Six-Four low"},
+		{"No qualifying low", []string{"S9", "HT", "DJ", "CQ", "SK", "HA", "DA"}, nil, ""},
+		{"Only four low ranks", []string{"S2", "H2", "D3", "C4", "S5", "HK", "DQ"}, nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cards, _ := ParseCards(tt.cards)
+			low := EvaluateLowEight(cards)
+			if tt.detail == nil {
+				if low != nil {
+					t.Fatalf("Expected no low, got %s", low.Description)
+				}
+				return
+			}
+			if low == nil {
+				t.Fatal("Expected a qualifying low")
+			}
+			if !reflect.DeepEqual(low.RankDetail, tt.detail) || low.Description != tt.description {
+				t.Errorf("Expected %v %q, got %v %q", tt.detail, tt.description, low.RankDetail, low.Description)
+			}
+			if !low.IsLow() || len(low.Cards) != 5 {
+				t.Errorf("Expected a five-card low hand, got %v", low.Cards)
+			}
+		})
+	}
+}
+
+func TestCompareLowHands(t *testing.T) {
+	wheel, _ := ParseCards([]string{"SA", "H2", "D3", "C4", "S5"})
+	six, _ := ParseCards([]string{"SA", "H2", "D3", "C4", "S6"})
+	if EvaluateLowEight(wheel).Compare(EvaluateLowEight(six)) != 1 {
+		t.Error("Expected the wheel to beat a six low")
+	}
+	if EvaluateLowEight(six).Compare(EvaluateLowEight(wheel)) != -1 {
+		t.Error("Expected a six low to lose to the wheel")
+	}
+}
+
+func TestEvaluateOmahaLow(t *testing.T) {
+	tests := []struct {
+		name   string
+		hole   []string
+		board  []string
+		detail []int
+	}{
+		{"Two low hole cards", []string{"SA", "H2", "DK", "CK"}, []string{"S3", "H4", "D8", "CQ", "SJ"}, []int{8, 4, 3, 2, 1}},
+		{"Only one low hole card", []string{"SA", "HK", "DK", "CQ"}, []string{"S3", "H4", "D5", "C6", "SJ"}, nil},
+		{"Counterfeited hole card", []string{"SA", "H2", "DK", "CQ"}, []string{"S2", "H3", "D7", "CK", "SJ"}, nil},
+		{"Only two low board cards", []string{"SA", "H2", "D3", "C4"}, []string{"S5", "H6", "DK", "CQ", "SJ"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hole, _ := ParseCards(tt.hole)
+			board, _ := ParseCards(tt.board)
+			low := EvaluateOmahaLow(hole, board)
+			if tt.detail == nil {
+				if low != nil {
+					t.Fatalf("Expected no low, got %s", low.Description)
+				}
+				return
+			}
+			if low == nil || !reflect.DeepEqual(low.RankDetail, tt.detail) {
+				t.Fatalf("Expected low %v, got %+v", tt.detail, low)
+			}
+		})
+	}
+}
+
+func TestSplitHiLoPot(t *testing.T) {
+	board, _ := ParseCards([]string{"S3", "H4", "D8", "CK", "SK"})
+	p1, _ := ParseCards([]string{"HA", "H2", "DK", "C9"}) // trip kings, A-2 low
+	p2, _ := ParseCards([]string{"DA", "D2", "CQ", "CJ"}) // kings up, A-2 low
+	p3, _ := ParseCards([]string{"CT", "DT", "HQ", "HJ"}) // kings and tens, no low
+
+	hands := make([]*HiLoHand, 3)
+	for i, hole := range [][]Card{p1, p2, p3} {
+		hand, err := Omaha8.EvaluateHiLo(hole, board)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		hands[i] = hand
+	}
+
+	pot := SplitHiLoPot(hands)
+	if !reflect.DeepEqual(pot.HighWinners, []int{0}) || !reflect.DeepEqual(pot.LowWinners, []int{0, 1}) {
+		t.Fatalf("Unexpected winners: high %v, low %v", pot.HighWinners, pot.LowWinners)
+	}
+	if pot.Shares[0] != 0.75 || pot.Shares[1] != 0.25 || pot.Shares[2] != 0 {
+		t.Errorf("Expected shares 0.75/0.25/0, got %v", pot.Shares)
+	}
+	if pot.Scooper != -1 {
+		t.Errorf("Expected no scoop, got player %d", pot.Scooper)
+	}
+
+	pot = SplitHiLoPot([]*HiLoHand{hands[0], hands[2]})
+	if pot.Scooper != 0 || pot.Shares[0] != 1 {
+		t.Errorf("Expected player 0 to scoop, got %+v", pot)
+	}
+}
+
+func TestCalculateWinProbability_HiLo(t *testing.T) {
+	hole, _ := ParseCards([]string{"HA", "S2", "H3", "SK"})
+	result, err := CalculateWinProbability(hole, nil, 4, 2000, WithGame(Omaha8))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.HiLo == nil {
+		t.Fatal("Expected a hi/lo breakdown")
+	}
+	if math.Abs(result.HiLo.ScoopProbability-result.WinProbability) > 1e-9 {
+		t.Errorf("Expected scoops %f to equal wins %f", result.HiLo.ScoopProbability, result.WinProbability)
+	}
+	partial := result.HiLo.HighOnlyProbability + result.HiLo.LowOnlyProbability + result.HiLo.ThreeQuarterProbability +
+		result.HiLo.QuarteredProbability + result.HiLo.SplitProbability
+	if math.Abs(partial-result.TieProbability) > 1e-9 {
+		t.Errorf("Expected partial shares %f to equal ties %f", partial, result.TieProbability)
+	}
+	if _, err := CalculateWinProbability(hole, nil, 4, 100, WithGame(Stud8)); err == nil {
+		t.Error("Expected error for a game without community cards")
+	}
+}
+
+func TestCalculateWinProbability_HiLoSplitWithoutLow(t *testing.T) {
+	// No low is possible on this board, so every shared pot is a high split
+	hole, _ := ParseCards([]string{"ST", "DT", "C2", "D3"})
+	board, _ := ParseCards([]string{"HA", "HK", "CQ", "CJ", "S9"})
+	result, err := CalculateWinProbability(hole, board, 2, 0, WithGame(Omaha8), WithMode(ExactMode))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	hilo := result.HiLo
+	if hilo.SplitProbability == 0 || math.Abs(hilo.SplitProbability-result.TieProbability) > 1e-9 {
+		t.Errorf("Expected every tie to be a high split, got ties %f and %+v", result.TieProbability, hilo)
+	}
+	sum := hilo.ScoopProbability + hilo.HighOnlyProbability + hilo.LowOnlyProbability +
+		hilo.ThreeQuarterProbability + hilo.QuarteredProbability + hilo.SplitProbability
+	if math.Abs(sum-result.WinProbability-result.TieProbability) > 1e-9 {
+		t.Errorf("Hi/lo breakdown %+v does not add up to win and tie", hilo)
+	}
+}
+
+func TestClassifyHiLo(t *testing.T) {
+	tests := []struct {
+		highShare, lowShare float64
+		hasLow              bool
+		want                hiLoOutcome
+	}{
+		{1, 0, false, hiLoScoop},
+		{0.5, 0, false, hiLoSplit},
+		{0, 0, false, hiLoNothing},
+		{1, 1, true, hiLoScoop},
+		{1, 0, true, hiLoHighOnly},
+		{0, 1, true, hiLoLowOnly},
+		{1, 0.5, true, hiLoThreeQuarter},
+		{0.5, 1, true, hiLoThreeQuarter},
+		{0, 0.5, true, hiLoQuartered},
+		{0.5, 0.5, true, hiLoQuartered},
+		{0, 0, true, hiLoNothing},
+	}
+
+	for _, tt := range tests {
+		if got := classifyHiLo(tt.highShare, tt.lowShare, tt.hasLow); got != tt.want {
+			t.Errorf("classifyHiLo(%v, %v, %v): Expected %d, got %d", tt.highShare, tt.lowShare, tt.hasLow, tt.want, got)
+		}
+	}
+}
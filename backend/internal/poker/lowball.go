@@ -0,0 +1,135 @@
+package poker
+
+import (
+	"fmt"
+	"sort"
+)
+
+// aceAlwaysHigh disables the wheel in evaluateFive: no rank plays below the
+// ace, so A-2-3-4-5 is not a straight
+const aceAlwaysHigh = 0
+
+// razzTables score ace-to-five lows for simulations; the best low has the
+// highest class
+var razzTables = newRankTables(2, evaluateAceToFiveFive, (*Hand).Compare)
+
+// EvaluateAceToFive evaluates the best ace-to-five low (as in Razz) from the
+// given cards. Aces are low, straights and flushes do not count, and pairs
+// are bad, so 5-4-3-2-A is the best possible hand.
+func EvaluateAceToFive(cards []Card) (*Hand, error) {
+	return bestLowball(cards, evaluateAceToFiveFive)
+}
+
+// EvaluateDeuceToSeven evaluates the best deuce-to-seven low from the given
+// cards. Aces are high and straights and flushes count against the hand, so
+// 7-5-4-3-2 of mixed suits is the best possible hand.
+func EvaluateDeuceToSeven(cards []Card) (*Hand, error) {
+	return bestLowball(cards, evaluateDeuceToSevenFive)
+}
+
+// bestLowball picks the best low of every 5-card combination
+func bestLowball(cards []Card, evaluate func([]Card) *Hand) (*Hand, error) {
+	if len(cards) < 5 {
+		return nil, fmt.Errorf("need at least 5 cards to evaluate a hand")
+	}
+	if HasDuplicates(cards) {
+		return nil, fmt.Errorf("duplicate cards in hand")
+	}
+
+	var best *Hand
+	forEachCombination(len(cards), 5, func(idx []int) bool {
+		combo := make([]Card, 5)
+		for i, j := range idx {
+			combo[i] = cards[j]
+		}
+		if hand := evaluate(combo); best == nil || hand.Compare(best) > 0 {
+			best = hand
+		}
+		return true
+	})
+	return best, nil
+}
+
+// evaluateDeuceToSevenFive scores five cards as the reverse of a high hand
+// in which the ace is always high
+func evaluateDeuceToSevenFive(cards []Card) *Hand {
+	hand := evaluateFive(cards, aceAlwaysHigh)
+	hand.low = true
+	if hand.Rank == HighCard {
+		hand.Description = lowDescription(hand.RankDetail)
+	}
+	return hand
+}
+
+// evaluateAceToFiveFive scores five cards with aces low, counting only
+// pairs, trips and quads
+func evaluateAceToFiveFive(cards []Card) *Hand {
+	sorted := make([]Card, len(cards))
+	copy(sorted, cards)
+	sort.Slice(sorted, func(i, j int) bool {
+		return lowValue(sorted[i].Rank) > lowValue(sorted[j].Rank)
+	})
+
+	var rankCounts [15]int
+	for _, card := range sorted {
+		rankCounts[lowValue(card.Rank)]++
+	}
+
+	// Group ranks by count, then by rank, both descending
+	type rankCount struct {
+		rank  int
+		count int
+	}
+	var counts []rankCount
+	for rank := 13; rank >= 1; rank-- {
+		if rankCounts[rank] > 0 {
+			counts = append(counts, rankCount{rank, rankCounts[rank]})
+		}
+	}
+	sort.SliceStable(counts, func(i, j int) bool {
+		return counts[i].count > counts[j].count
+	})
+
+	detail := make([]int, len(counts))
+	for i, c := range counts {
+		detail[i] = c.rank
+	}
+
+	hand := &Hand{RankDetail: detail, Cards: sorted, low: true}
+	top := lowRankToString(counts[0].rank)
+	switch {
+	case counts[0].count == 4:
+		hand.Rank = FourOfAKind
+		hand.Description = fmt.Sprintf("Four of a Kind, %ss", top)
+	case counts[0].count == 3 && counts[1].count == 2:
+		hand.Rank = FullHouse
+		hand.Description = fmt.Sprintf("Full House, %ss over %ss", top, lowRankToString(counts[1].rank))
+	case counts[0].count == 3:
+		hand.Rank = ThreeOfAKind
+		hand.Description = fmt.Sprintf("Three of a Kind, %ss", top)
+	case counts[0].count == 2 && counts[1].count == 2:
+		hand.Rank = TwoPair
+		hand.Description = fmt.Sprintf("Two Pair, %ss and %ss", top, lowRankToString(counts[1].rank))
+	case counts[0].count == 2:
+		hand.Rank = OnePair
+		hand.Description = fmt.Sprintf("One Pair, %ss", top)
+	default:
+		hand.Rank = HighCard
+		hand.Description = lowDescription(detail)
+	}
+	return hand
+}
+
+// lowDescription names an unpaired low by its two highest cards, such as
+// "Seven-Five low"
+func lowDescription(detail []int) string {
+	return fmt.Sprintf("%s-%s low", rankName(detail[0]), rankName(detail[1]))
+}
+
+// lowRankToString converts a low-hand rank, where 1 is the ace, to a string
+func lowRankToString(rank int) string {
+	if rank == 1 {
+		return "Ace"
+	}
+	return rankToString(rank)
+}
@@ -0,0 +1,96 @@
+package poker
+
+import (
+	"testing"
+)
+
+func TestEvaluateAceToFive(t *testing.T) {
+	tests := []struct {
+		name        string
+		cards       []string
+		rank        HandRank
+		description string
+	}{
+		{"Wheel is the nuts", []string{"SA", "H2", "D3", "C4", "S5", "HK", "DK"}, HighCard, "Five-Four low"},
+		{"Straights and flushes do not count", []string{"H3", "H4", "H5", "H6", "H7"}, HighCard, "Seven-Six low"},
+		{"Best unpaired five of seven", []string{"S9", "H7", "D5", "C4", "S2", "H2", "DK"}, HighCard, "Nine-Seven low"},
+		{"Forced pair", []string{"SA", "HA", "D2", "C2", "S3"}, TwoPair, "Two Pair, 2s and Aces"},
+		{"Pair of aces is the lowest pair", []string{"SA", "HA", "D2", "C3", "S4"}, OnePair, "One Pair, Aces"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cards, _ := ParseCards(tt.cards)
+			hand, err := EvaluateAceToFive(cards)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if hand.Rank != tt.rank || hand.Description != tt.description {
+				t.Errorf("Expected %s %q, got %s %q", tt.rank, tt.description, hand.Rank, hand.Description)
+			}
+		})
+	}
+}
+
+func TestEvaluateDeuceToSeven(t *testing.T) {
+	tests := []struct {
+		name        string
+		cards       []string
+		rank        HandRank
+		description string
+	}{
+		{"Number one", []string{"S7", "H5", "D4", "C3", "S2"}, HighCard, "Seven-Five low"},
+		{"Ace is high", []string{"SA", "H2", "D3", "C4", "S5"}, HighCard, "Ace-Five low"},
+		{"Straight counts", []string{"S6", "H5", "D4", "C3", "S2"}, Straight, "Straight, 6 high"},
+		{"Flush counts", []string{"H8", "H5", "H4", "H3", "H2"}, Flush, "Flush, 8 high"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cards, _ := ParseCards(tt.cards)
+			hand, err := EvaluateDeuceToSeven(cards)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if hand.Rank != tt.rank || hand.Description != tt.description {
+				t.Errorf("Expected %s %q, got %s %q", tt.rank, tt.description, hand.Rank, hand.Description)
+			}
+		})
+	}
+}
+
+func TestCompareLowball(t *testing.T) {
+	tests := []struct {
+		name     string
+		game     Game
+		hand1    []string
+		hand2    []string
+		expected int
+	}{
+		{"Razz: wheel beats six low", Razz, []string{"SA", "H2", "D3", "C4", "S5", "HK", "DQ"}, []string{"SA", "H2", "D3", "C4", "S6", "HK", "DQ"}, 1},
+		{"Razz: any low beats a pair", Razz, []string{"SK", "HQ", "DJ", "CT", "S9"}, []string{"SA", "HA", "D2", "C3", "S4"}, 1},
+		{"Razz: lower second card wins", Razz, []string{"S8", "H5", "D4", "C3", "S2"}, []string{"S8", "H6", "D4", "C3", "S2"}, 1},
+		{"2-7: seven-five beats eight", DeuceToSeven, []string{"S7", "H5", "D4", "C3", "S2"}, []string{"S8", "H5", "D4", "C3", "S2"}, 1},
+		{"2-7: ace-high loses to king-high", DeuceToSeven, []string{"SA", "H5", "D4", "C3", "S2"}, []string{"SK", "H5", "D4", "C3", "S2"}, -1},
+		{"2-7: straight loses to eight low", DeuceToSeven, []string{"S6", "H5", "D4", "C3", "S2"}, []string{"S8", "H5", "D4", "C3", "S2"}, -1},
+		{"2-7: same low ties", DeuceToSeven, []string{"S7", "H5", "D4", "C3", "S2"}, []string{"H7", "D5", "C4", "S3", "H2"}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cards1, _ := ParseCards(tt.hand1)
+			cards2, _ := ParseCards(tt.hand2)
+			hand1, err := tt.game.Evaluate(cards1, nil)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			hand2, err := tt.game.Evaluate(cards2, nil)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result := hand1.Compare(hand2); result != tt.expected {
+				t.Errorf("Expected %d, got %d. Hand1: %s, Hand2: %s", tt.expected, result, hand1.Description, hand2.Description)
+			}
+		})
+	}
+}
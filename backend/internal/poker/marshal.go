@@ -0,0 +1,118 @@
+package poker
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Cards, hand ranks and hands serialize to stable forms that parse back to
+// the same values: a card is written "Ah", a rank by its name.
+
+// MarshalText writes the card in rank-suit form, such as "Ah"
+func (c Card) MarshalText() ([]byte, error) {
+	if c.Rank < 2 || c.Rank > 14 || !strings.Contains("HDCS", c.Suit) || len(c.Suit) != 1 {
+		return nil, fmt.Errorf("invalid card: %+v", c)
+	}
+	return []byte(c.Format(ASCIIFormat)), nil
+}
+
+// UnmarshalText reads a card in any notation ParseCard accepts
+func (c *Card) UnmarshalText(text []byte) error {
+	card, err := ParseCard(string(text))
+	if err != nil {
+		return err
+	}
+	*c = card
+	return nil
+}
+
+// MarshalText writes the rank's name, such as "Full House"
+func (hr HandRank) MarshalText() ([]byte, error) {
+	if hr < HighCard || hr > RoyalFlush {
+		return nil, fmt.Errorf("invalid hand rank: %d", int(hr))
+	}
+	return []byte(hr.String()), nil
+}
+
+// UnmarshalText reads a rank name, ignoring case
+func (hr *HandRank) UnmarshalText(text []byte) error {
+	rank, err := ParseHandRank(string(text))
+	if err != nil {
+		return err
+	}
+	*hr = rank
+	return nil
+}
+
+// ParseHandRank converts a rank name such as "Two Pair" to a HandRank
+func ParseHandRank(s string) (HandRank, error) {
+	name := strings.TrimSpace(s)
+	for hr := HighCard; hr <= RoyalFlush; hr++ {
+		if strings.EqualFold(hr.String(), name) {
+			return hr, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown hand rank: %s", s)
+}
+
+// handJSON is the serialized form of a Hand
+type handJSON struct {
+	Rank        HandRank     `json:"rank"`
+	RankDetail  []int        `json:"rankDetail"`
+	Cards       []Card       `json:"cards"`
+	Description string       `json:"description"`
+	Strength    HandStrength `json:"strength,omitempty"`
+	Low         bool         `json:"low,omitempty"`
+	Order       Game         `json:"order,omitempty"` // the short deck game whose category order applies
+}
+
+// MarshalJSON writes the hand with everything needed to compare it again
+// after UnmarshalJSON. It has a value receiver so that a Hand value, or one
+// inside a struct, is written the same way as a *Hand.
+func (h Hand) MarshalJSON() ([]byte, error) {
+	v := handJSON{
+		Rank:        h.Rank,
+		RankDetail:  h.RankDetail,
+		Cards:       h.Cards,
+		Description: h.Description,
+		Strength:    h.strength,
+		Low:         h.low,
+	}
+	switch h.order {
+	case nil:
+	case &shortDeckOrder:
+		v.Order = ShortDeck
+	case &shortDeckClassicOrder:
+		v.Order = ShortDeckClassic
+	default:
+		return nil, fmt.Errorf("unknown category order")
+	}
+	return json.Marshal(v)
+}
+
+// UnmarshalJSON reads a hand written by MarshalJSON
+func (h *Hand) UnmarshalJSON(data []byte) error {
+	var v handJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*h = Hand{
+		Rank:        v.Rank,
+		RankDetail:  v.RankDetail,
+		Cards:       v.Cards,
+		Description: v.Description,
+		strength:    v.Strength,
+		low:         v.Low,
+	}
+	switch v.Order {
+	case "":
+	case ShortDeck:
+		h.order = &shortDeckOrder
+	case ShortDeckClassic:
+		h.order = &shortDeckClassicOrder
+	default:
+		return fmt.Errorf("unknown category order: %s", v.Order)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package poker
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestCardMarshalJSON(t *testing.T) {
+	cards, _ := ParseCards([]string{"HA", "CT", "D2"})
+	data, err := json.Marshal(cards)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if string(data) != `["Ah","Tc","2d"]` {
+		t.Errorf("Unexpected JSON %s", data)
+	}
+
+	var back []Card
+	if err := json.Unmarshal(data, &back); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(back, cards) {
+		t.Errorf("Expected %v, got %v", cards, back)
+	}
+
+	if _, err := json.Marshal(Card{}); err == nil {
+		t.Error("Expected error marshaling the zero card")
+	}
+	if err := json.Unmarshal([]byte(`"Zz"`), &back); err == nil {
+		t.Error("Expected error for an invalid card")
+	}
+}
+
+func TestHandRankMarshalText(t *testing.T) {
+	for hr := HighCard; hr <= RoyalFlush; hr++ {
+		data, err := json.Marshal(hr)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		var back HandRank
+		if err := json.Unmarshal(data, &back); err != nil || back != hr {
+			t.Errorf("Round trip of %s gave %s, %v", hr, back, err)
+		}
+	}
+	if _, err := ParseHandRank("full house"); err != nil {
+		t.Errorf("Expected names to ignore case: %v", err)
+	}
+	if _, err := ParseHandRank("Five of a Kind"); err == nil {
+		t.Error("Expected error for an unknown rank")
+	}
+}
+
+func TestHandMarshalJSON_RoundTrip(t *testing.T) {
+	hole, _ := ParseCards([]string{"HA", "HK"})
+	board, _ := ParseCards([]string{"H9", "H7", "S9", "C9", "D6"})
+
+	for _, game := range []Game{Holdem, ShortDeck, Razz} {
+		hand, err := game.Evaluate(hole, board)
+		if err != nil {
+			t.Fatalf("%s: Unexpected error: %v", game, err)
+		}
+		data, err := json.Marshal(hand)
+		if err != nil {
+			t.Fatalf("%s: Unexpected error: %v", game, err)
+		}
+		var back Hand
+		if err := json.Unmarshal(data, &back); err != nil {
+			t.Fatalf("%s: Unexpected error: %v", game, err)
+		}
+		if !reflect.DeepEqual(&back, hand) {
+			t.Errorf("%s: Round trip of %s gave %+v, want %+v", game, data, back, *hand)
+		}
+	}
+}
+
+func TestHandMarshalJSON_Value(t *testing.T) {
+	cards, _ := ParseCards([]string{"HA", "HK", "HQ", "HJ", "HT"})
+	hand, err := EvaluateHand(cards)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	byPointer, err := json.Marshal(hand)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	byValue, err := json.Marshal(*hand)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if string(byValue) != string(byPointer) {
+		t.Errorf("Expected a Hand value to marshal like a *Hand, got %s and %s", byValue, byPointer)
+	}
+
+	// A Hand held by value inside a struct round-trips too
+	type holder struct{ Hand Hand }
+	data, err := json.Marshal(holder{*hand})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var back holder
+	if err := json.Unmarshal(data, &back); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(back.Hand, *hand) {
+		t.Errorf("Round trip of %s gave %+v, want %+v", data, back.Hand, *hand)
+	}
+}
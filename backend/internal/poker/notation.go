@@ -0,0 +1,175 @@
+package poker
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// CardFormat selects how cards are written out
+type CardFormat string
+
+const (
+	GlyphFormat    CardFormat = "glyph"     // suit symbol then rank, "♥A", as Card.String
+	ASCIIFormat    CardFormat = "ascii"     // rank then lower-case suit letter, "Ah"
+	SuitRankFormat CardFormat = "suit-rank" // suit letter then rank, "HA"
+	UnicodeFormat  CardFormat = "unicode"   // one Unicode playing card character, "🂱"
+)
+
+// cardFormatAliases maps accepted spellings to card formats
+var cardFormatAliases = map[string]CardFormat{
+	"":          GlyphFormat,
+	"glyph":     GlyphFormat,
+	"glyphs":    GlyphFormat,
+	"default":   GlyphFormat,
+	"ascii":     ASCIIFormat,
+	"rank-suit": ASCIIFormat,
+	"standard":  ASCIIFormat,
+	"suit-rank": SuitRankFormat,
+	"legacy":    SuitRankFormat,
+	"unicode":   UnicodeFormat,
+}
+
+// ParseCardFormat converts a format name such as "ascii" to a CardFormat.
+// An empty name selects the glyph format.
+func ParseCardFormat(s string) (CardFormat, error) {
+	format, ok := cardFormatAliases[strings.ToLower(strings.TrimSpace(s))]
+	if !ok {
+		return "", fmt.Errorf("unknown card format: %s", s)
+	}
+	return format, nil
+}
+
+// rankChars spells each rank with a single character; index 10 is the ten
+var rankChars = "..23456789TJQKA"
+
+// playingCardBase is the Unicode code point before the ace of each suit
+var playingCardBase = map[string]rune{"S": 0x1F0A0, "H": 0x1F0B0, "D": 0x1F0C0, "C": 0x1F0D0}
+
+// Format writes the card in the given format
+func (c Card) Format(f CardFormat) string {
+	rank := string(rankChars[c.Rank])
+	switch f {
+	case ASCIIFormat:
+		return rank + strings.ToLower(c.Suit)
+	case SuitRankFormat:
+		return c.Suit + rank
+	case UnicodeFormat:
+		offset := rune(c.Rank)
+		switch c.Rank {
+		case 14:
+			offset = 1
+		case 12, 13:
+			offset++ // skip the knight
+		}
+		return string(playingCardBase[c.Suit] + offset)
+	default:
+		return c.String()
+	}
+}
+
+// FormatCards writes each card in the given format
+func FormatCards(cards []Card, f CardFormat) []string {
+	result := make([]string, len(cards))
+	for i, card := range cards {
+		result[i] = card.Format(f)
+	}
+	return result
+}
+
+// ParseCardString parses every card in a string such as "AhKd", "Ah Kd Qc"
+// or "HA,SK". Cards may be separated by spaces or commas or run together.
+func ParseCardString(s string) ([]Card, error) {
+	runes := []rune(s)
+	var cards []Card
+	for i := 0; i < len(runes); {
+		if unicode.IsSpace(runes[i]) || runes[i] == ',' {
+			i++
+			continue
+		}
+		card, n, err := scanCard(runes[i:])
+		if err != nil {
+			return nil, err
+		}
+		cards = append(cards, card)
+		i += n
+	}
+	return cards, nil
+}
+
+// scanCard reads one card from the start of runes and returns it with the
+// number of runes it used
+func scanCard(runes []rune) (Card, int, error) {
+	if card, ok := parsePlayingCard(runes[0]); ok {
+		return card, 1, nil
+	}
+
+	// Suit first: "HA", "♠10"
+	if suit, ok := parseSuit(runes[0]); ok {
+		rank, n := parseRank(runes[1:])
+		if n == 0 {
+			return Card{}, 0, fmt.Errorf("invalid rank: %s", string(runes[1:]))
+		}
+		return Card{Rank: rank, Suit: suit}, n + 1, nil
+	}
+
+	// Rank first: "Ah", "10h", "A♠"
+	rank, n := parseRank(runes)
+	if n == 0 {
+		return Card{}, 0, fmt.Errorf("invalid card format: %s", string(runes))
+	}
+	if n == len(runes) {
+		return Card{}, 0, fmt.Errorf("missing suit: %s", string(runes))
+	}
+	suit, ok := parseSuit(runes[n])
+	if !ok {
+		return Card{}, 0, fmt.Errorf("invalid suit: %s", string(runes[n]))
+	}
+	return Card{Rank: rank, Suit: suit}, n + 1, nil
+}
+
+// parseSuit reads a suit letter or symbol
+func parseSuit(r rune) (string, bool) {
+	switch unicode.ToUpper(r) {
+	case 'H', '♥', '♡':
+		return "H", true
+	case 'D', '♦', '♢':
+		return "D", true
+	case 'C', '♣', '♧':
+		return "C", true
+	case 'S', '♠', '♤':
+		return "S", true
+	}
+	return "", false
+}
+
+// parseRank reads a rank from the start of runes and returns it with the
+// number of runes it used, or 0 runes when there is no rank
+func parseRank(runes []rune) (int, int) {
+	if len(runes) == 0 {
+		return 0, 0
+	}
+	if len(runes) >= 2 && runes[0] == '1' && runes[1] == '0' {
+		return 10, 2
+	}
+	if i := strings.IndexRune(rankChars, unicode.ToUpper(runes[0])); i >= 2 {
+		return i, 1
+	}
+	return 0, 0
+}
+
+// parsePlayingCard reads a Unicode playing card character
+func parsePlayingCard(r rune) (Card, bool) {
+	for suit, base := range playingCardBase {
+		offset := int(r - base)
+		switch {
+		case offset == 1:
+			return Card{Rank: 14, Suit: suit}, true
+		case offset >= 2 && offset <= 11:
+			return Card{Rank: offset, Suit: suit}, true
+		case offset == 13 || offset == 14:
+			return Card{Rank: offset - 1, Suit: suit}, true
+		}
+	}
+	return Card{}, false
+}
@@ -0,0 +1,102 @@
+package poker
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseCard_Notations(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Card
+	}{
+		{"Ah", Card{Rank: 14, Suit: "H"}},
+		{"as", Card{Rank: 14, Suit: "S"}},
+		{"10h", Card{Rank: 10, Suit: "H"}},
+		{"Td", Card{Rank: 10, Suit: "D"}},
+		{"H10", Card{Rank: 10, Suit: "H"}},
+		{"♠A", Card{Rank: 14, Suit: "S"}},
+		{"A♠", Card{Rank: 14, Suit: "S"}},
+		{"♡Q", Card{Rank: 12, Suit: "H"}},
+		{" Kc ", Card{Rank: 13, Suit: "C"}},
+		{"🂱", Card{Rank: 14, Suit: "H"}},
+		{"🃞", Card{Rank: 13, Suit: "C"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			card, err := ParseCard(tt.input)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if card != tt.expected {
+				t.Errorf("Expected %+v, got %+v", tt.expected, card)
+			}
+		})
+	}
+
+	for _, input := range []string{"", "1h", "Ax", "AhKd", "🂬", "A"} {
+		if _, err := ParseCard(input); err == nil {
+			t.Errorf("Expected error for %q", input)
+		}
+	}
+}
+
+func TestParseCardString(t *testing.T) {
+	expected := []Card{{Rank: 14, Suit: "H"}, {Rank: 13, Suit: "D"}, {Rank: 10, Suit: "C"}}
+	for _, input := range []string{"AhKd10c", "Ah Kd Tc", "HA,DK,CT", "♥A ♦K ♣10"} {
+		cards, err := ParseCardString(input)
+		if err != nil {
+			t.Fatalf("Unexpected error for %q: %v", input, err)
+		}
+		if !reflect.DeepEqual(cards, expected) {
+			t.Errorf("For %q expected %v, got %v", input, expected, cards)
+		}
+	}
+
+	if _, err := ParseCardString("AhKx"); err == nil {
+		t.Error("Expected error for an invalid card")
+	}
+
+	cards, err := ParseCards([]string{"AhKd", "Qc"})
+	if err != nil || len(cards) != 3 {
+		t.Errorf("Expected ParseCards to split combined cards, got %v, %v", cards, err)
+	}
+}
+
+func TestCardFormat(t *testing.T) {
+	card := Card{Rank: 12, Suit: "S"}
+	tests := []struct {
+		format   CardFormat
+		expected string
+	}{
+		{GlyphFormat, "♠Q"},
+		{ASCIIFormat, "Qs"},
+		{SuitRankFormat, "SQ"},
+		{UnicodeFormat, "🂭"},
+	}
+	for _, tt := range tests {
+		if got := card.Format(tt.format); got != tt.expected {
+			t.Errorf("Format %s: Expected %s, got %s", tt.format, tt.expected, got)
+		}
+	}
+
+	if _, err := ParseCardFormat("fancy"); err == nil {
+		t.Error("Expected error for an unknown format")
+	}
+	if f, _ := ParseCardFormat(""); f != GlyphFormat {
+		t.Errorf("Expected the glyph format by default, got %s", f)
+	}
+}
+
+func TestCardFormat_RoundTrip(t *testing.T) {
+	for _, id := range FullDeck.IDs() {
+		card := id.Card()
+		for _, format := range []CardFormat{GlyphFormat, ASCIIFormat, SuitRankFormat, UnicodeFormat} {
+			back, err := ParseCard(card.Format(format))
+			if err != nil || back != card {
+				t.Errorf("Round trip of %+v through %s gave %+v, %v", card, format, back, err)
+			}
+		}
+	}
+}
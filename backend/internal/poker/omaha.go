@@ -0,0 +1,59 @@
+package poker
+
+import (
+	"fmt"
+)
+
+// EvaluateOmaha evaluates the best Omaha hand, which must use exactly two of
+// the hole cards and exactly three community cards
+func EvaluateOmaha(holeCards []Card, communityCards []Card) (*Hand, error) {
+	if len(holeCards) < 4 || len(holeCards) > 6 {
+		return nil, fmt.Errorf("omaha needs 4 to 6 hole cards")
+	}
+	if len(communityCards) < 3 || len(communityCards) > 5 {
+		return nil, fmt.Errorf("omaha needs 3 to 5 community cards")
+	}
+	allCards := append(append([]Card{}, holeCards...), communityCards...)
+	if HasDuplicates(allCards) {
+		return nil, fmt.Errorf("duplicate cards in hand")
+	}
+
+	var bestClass uint16
+	var bestCombo [5]Card
+	forEachCombination(len(holeCards), 2, func(hi []int) bool {
+		forEachCombination(len(communityCards), 3, func(bi []int) bool {
+			cs := NewCardSet(holeCards[hi[0]], holeCards[hi[1]],
+				communityCards[bi[0]], communityCards[bi[1]], communityCards[bi[2]])
+			if v := standardTables.evaluate(cs); v > bestClass {
+				bestClass = v
+				bestCombo = [5]Card{holeCards[hi[0]], holeCards[hi[1]],
+					communityCards[bi[0]], communityCards[bi[1]], communityCards[bi[2]]}
+			}
+			return true
+		})
+		return true
+	})
+
+	return standardTables.hand(bestClass, bestCombo[:]), nil
+}
+
+// omahaScore returns the best two-hole-card, three-board-card class
+func omahaScore(t *rankTables, hole []CardID, board []CardID) uint16 {
+	best := uint16(0)
+	for i := 0; i < len(hole); i++ {
+		for j := i + 1; j < len(hole); j++ {
+			pair := cardBit(hole[i]) | cardBit(hole[j])
+			for a := 0; a < len(board); a++ {
+				for b := a + 1; b < len(board); b++ {
+					for c := b + 1; c < len(board); c++ {
+						cs := pair | cardBit(board[a]) | cardBit(board[b]) | cardBit(board[c])
+						if v := t.evaluate(cs); v > best {
+							best = v
+						}
+					}
+				}
+			}
+		}
+	}
+	return best
+}
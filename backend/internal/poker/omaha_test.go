@@ -0,0 +1,103 @@
+package poker
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEvaluateOmaha_ExactlyTwoHoleCards(t *testing.T) {
+	tests := []struct {
+		name     string
+		hole     []string
+		board    []string
+		expected HandRank
+	}{
+		{
+			name:     "One suited hole card makes no flush",
+			hole:     []string{"HA", "SA", "SK", "DQ"},
+			board:    []string{"H2", "H5", "H9", "HK", "D3"},
+			expected: OnePair,
+		},
+		{
+			name:     "Four to a straight on board needs two hole cards",
+			hole:     []string{"S9", "D2", "C2", "H3"},
+			board:    []string{"H8", "D7", "C6", "S5", "HK"},
+			expected: OnePair,
+		},
+		{
+			name:     "Two suited hole cards make the flush",
+			hole:     []string{"HA", "HQ", "SK", "DQ"},
+			board:    []string{"H2", "H5", "H9", "CK", "D3"},
+			expected: Flush,
+		},
+		{
+			name:     "Trips on board plays as a full house with a pocket pair",
+			hole:     []string{"SK", "DK", "C4", "D9"},
+			board:    []string{"SA", "HA", "DA", "C7", "H2"},
+			expected: FullHouse,
+		},
+		{
+			name:     "Five-card Omaha",
+			hole:     []string{"HA", "HK", "S2", "D3", "C4"},
+			board:    []string{"HQ", "HJ", "HT", "S9", "D8"},
+			expected: RoyalFlush,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hole, _ := ParseCards(tt.hole)
+			board, _ := ParseCards(tt.board)
+			hand, err := EvaluateOmaha(hole, board)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if hand.Rank != tt.expected {
+				t.Errorf("Expected %s, got %s (%s)", tt.expected, hand.Rank, hand.Description)
+			}
+			holeUsed := 0
+			for _, card := range hand.Cards {
+				if NewCardSet(hole...).Contains(card) {
+					holeUsed++
+				}
+			}
+			if holeUsed != 2 {
+				t.Errorf("Expected exactly 2 hole cards in %v, got %d", hand.Cards, holeUsed)
+			}
+		})
+	}
+}
+
+func TestGameEvaluate_HoleCardCount(t *testing.T) {
+	hole, _ := ParseCards([]string{"HA", "HK", "HQ"})
+	board, _ := ParseCards([]string{"H2", "H5", "H9"})
+	if _, err := Omaha.Evaluate(hole, board); err == nil {
+		t.Error("Expected error for three hole cards in Omaha")
+	}
+	if _, err := ParseGame("stud-poker"); err == nil {
+		t.Error("Expected error for unknown game")
+	}
+	if game, _ := ParseGame("PLO6"); game != Omaha6 || game.HoleCards() != 6 {
+		t.Errorf("Expected PLO6 to parse as six-card Omaha, got %s", game)
+	}
+}
+
+func TestCalculateWinProbability_Omaha(t *testing.T) {
+	hole, _ := ParseCards([]string{"HA", "SA", "HK", "SK"})
+	result, err := CalculateWinProbability(hole, nil, 6, 2000, WithGame(Omaha))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	sum := result.WinProbability + result.TieProbability + result.LossProbability
+	if math.Abs(sum-1) > 1e-9 {
+		t.Errorf("Probabilities sum to %f", sum)
+	}
+
+	if _, err := CalculateWinProbability(hole[:2], nil, 6, 100, WithGame(Omaha)); err == nil {
+		t.Error("Expected error for two hole cards in Omaha")
+	}
+	six, _ := ParseCards([]string{"HA", "SA", "HK", "SK", "H2", "S2"})
+	if _, err := CalculateWinProbability(six, nil, 9, 100, WithGame(Omaha6)); err == nil {
+		t.Error("Expected error when the deck cannot cover every player")
+	}
+}
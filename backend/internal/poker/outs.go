@@ -0,0 +1,176 @@
+package poker
+
+import "fmt"
+
+// Out is a next community card that helps the hero
+type Out struct {
+	Card Card
+	Rank HandRank // the hero's hand category with the card on the board
+}
+
+// OutsAnalysis lists the hero's outs on a flop or turn. Without opponents an
+// out is a card that lifts the hero to a better category than both their
+// current hand and the board itself; with known opponent hands it is a card
+// that puts a hero who is not already ahead in front of all of them.
+type OutsAnalysis struct {
+	Street string   // the street the next card makes: "turn" or "river"
+	Rank   HandRank // the hero's current hand category
+	Ahead  bool     // the hero already beats every opponent given
+	Draws  []Draw
+	Outs   []Out
+	Unseen int // the cards the next card can be
+
+	// Probability is the exact chance of catching an out by the river: on
+	// the flop, on either the turn or the river
+	Probability float64
+
+	// RuleOfTwoAndFour estimates Probability at 4% per out with two cards
+	// to come and 2% per out with one
+	RuleOfTwoAndFour float64
+}
+
+// Outs finds the hero's Hold'em outs and draws on a flop or turn, against
+// optional known opponent hands
+func Outs(holeCards, communityCards []Card, opponents ...[]Card) (*OutsAnalysis, error) {
+	return Holdem.Outs(holeCards, communityCards, opponents...)
+}
+
+// Outs finds the hero's outs and draws on a flop or turn under the rules of
+// a game with two hole cards, against optional known opponent hands
+func (g Game) Outs(holeCards, communityCards []Card, opponents ...[]Card) (*OutsAnalysis, error) {
+	if g.HoleCards() != 2 || !g.HasBoard() {
+		return nil, fmt.Errorf("outs need a game with two hole cards and a board, not %s", g)
+	}
+	if len(holeCards) != 2 {
+		return nil, fmt.Errorf("must have exactly 2 hole cards")
+	}
+	if len(communityCards) != 3 && len(communityCards) != 4 {
+		return nil, fmt.Errorf("outs need a flop or a turn, not %d community cards", len(communityCards))
+	}
+	if len(opponents) > 9 {
+		return nil, fmt.Errorf("number of players must be between 2 and 10")
+	}
+	known := append(append([]Card{}, holeCards...), communityCards...)
+	for i, hole := range opponents {
+		if len(hole) != 2 {
+			return nil, fmt.Errorf("opponent %d must have exactly 2 hole cards", i+1)
+		}
+		known = append(known, hole...)
+	}
+	if HasDuplicates(known) {
+		return nil, fmt.Errorf("duplicate cards detected")
+	}
+	if NewCardSet(known...).Remove(g.Deck()) != 0 {
+		return nil, fmt.Errorf("cards must come from the %s deck", g)
+	}
+
+	hand, err := g.Evaluate(holeCards, communityCards)
+	if err != nil {
+		return nil, err
+	}
+	unseen := g.Deck().Remove(NewCardSet(known...))
+	analysis := &OutsAnalysis{
+		Street: "turn",
+		Rank:   hand.Rank,
+		Draws:  findDraws(g, holeCards, communityCards, hand, unseen),
+		Unseen: unseen.Count(),
+	}
+	if len(communityCards) == 4 {
+		analysis.Street = "river"
+	}
+	if len(opponents) > 0 {
+		if analysis.Ahead, err = g.beatsAll(hand, communityCards, opponents); err != nil {
+			return nil, err
+		}
+	}
+
+	next := append(append([]Card{}, communityCards...), Card{})
+	for _, id := range unseen.IDs() {
+		next[len(communityCards)] = id.Card()
+		after, err := g.Evaluate(holeCards, next)
+		if err != nil {
+			return nil, err
+		}
+
+		var isOut bool
+		if len(opponents) == 0 {
+			isOut = after.outranks(hand.Rank) && after.outranks(boardRank(g, next))
+		} else if !analysis.Ahead {
+			if isOut, err = g.beatsAll(after, next, opponents); err != nil {
+				return nil, err
+			}
+		}
+		if isOut {
+			analysis.Outs = append(analysis.Outs, Out{Card: id.Card(), Rank: after.Rank})
+		}
+	}
+
+	outs, cards := float64(len(analysis.Outs)), float64(analysis.Unseen)
+	if analysis.Street == "river" {
+		analysis.Probability = outs / cards
+		analysis.RuleOfTwoAndFour = min(1, 0.02*outs)
+	} else {
+		analysis.Probability = 1 - (cards-outs)*(cards-outs-1)/(cards*(cards-1))
+		analysis.RuleOfTwoAndFour = min(1, 0.04*outs)
+	}
+	return analysis, nil
+}
+
+// beatsAll reports whether the hero's hand beats every opponent's on board
+func (g Game) beatsAll(hand *Hand, board []Card, opponents [][]Card) (bool, error) {
+	for i, hole := range opponents {
+		opponent, err := g.Evaluate(hole, board)
+		if err != nil {
+			return false, fmt.Errorf("opponent %d: %v", i+1, err)
+		}
+		if hand.Compare(opponent) <= 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// outranks reports whether the hand's category ranks above r in the hand's
+// own ruleset
+func (h *Hand) outranks(r HandRank) bool {
+	return h.category() > (&Hand{Rank: r, order: h.order}).category()
+}
+
+// boardRank returns the category the community cards make by themselves.
+// Fewer than five cards can only pair up.
+func boardRank(g Game, board []Card) HandRank {
+	if len(board) >= 5 {
+		if hand, err := g.Evaluate(nil, board); err == nil {
+			return hand.Rank
+		}
+	}
+	return pairedRank(NewCardSet(board...))
+}
+
+// pairedRank returns the best category that matching ranks alone make from
+// up to five cards: a pair, two pair, trips, a full house or quads
+func pairedRank(cs CardSet) HandRank {
+	counts := cs.rankCounts()
+	pairs, trips := 0, 0
+	for _, count := range counts {
+		switch count {
+		case 4:
+			return FourOfAKind
+		case 3:
+			trips++
+		case 2:
+			pairs++
+		}
+	}
+	switch {
+	case trips > 0 && pairs > 0:
+		return FullHouse
+	case trips > 0:
+		return ThreeOfAKind
+	case pairs > 1:
+		return TwoPair
+	case pairs == 1:
+		return OnePair
+	}
+	return HighCard
+}
@@ -0,0 +1,114 @@
+package poker
+
+import (
+	"math"
+	"testing"
+)
+
+func TestOuts_Draws(t *testing.T) {
+	tests := []struct {
+		name  string
+		hole  []string
+		board []string
+		draws []DrawType
+		outs  int
+	}{
+		{"flush draw and overcards", []string{"HA", "HK"}, []string{"H2", "H7", "C9"}, []DrawType{FlushDraw, Overcards}, 15},
+		{"open-ender", []string{"S8", "S7"}, []string{"H6", "D5", "CK"}, []DrawType{OpenEndedDraw}, 14},
+		{"wheel open-ender", []string{"S5", "S4"}, []string{"H3", "D2", "CK"}, []DrawType{OpenEndedDraw}, 14},
+		{"double gutshot", []string{"S9", "S7"}, []string{"HJ", "D5", "C8"}, []DrawType{DoubleGutshot}, 14},
+		{"gutshot on the turn", []string{"S8", "S7"}, []string{"H5", "D4", "CK", "C2"}, []DrawType{Gutshot}, 10},
+		{"backdoors", []string{"HQ", "HJ"}, []string{"H4", "CT", "D2"}, []DrawType{BackdoorFlushDraw, BackdoorStraightDraw, Overcards}, 6},
+		{"made straight", []string{"S8", "S7"}, []string{"H6", "D5", "C4"}, nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hole, _ := ParseCards(tt.hole)
+			board, _ := ParseCards(tt.board)
+			analysis, err := Outs(hole, board)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			var draws []DrawType
+			for _, draw := range analysis.Draws {
+				draws = append(draws, draw.Type)
+			}
+			if len(draws) != len(tt.draws) {
+				t.Fatalf("Expected draws %v, got %v", tt.draws, draws)
+			}
+			for i := range draws {
+				if draws[i] != tt.draws[i] {
+					t.Errorf("Expected draws %v, got %v", tt.draws, draws)
+				}
+			}
+			if len(analysis.Outs) != tt.outs {
+				t.Errorf("Expected %d outs, got %d: %v", tt.outs, len(analysis.Outs), analysis.Outs)
+			}
+		})
+	}
+}
+
+func TestOuts_Probability(t *testing.T) {
+	// Nine flush outs on the flop: 1 - 38*37 / (47*46) to hit by the river
+	hole, _ := ParseCards([]string{"HA", "H3"})
+	board, _ := ParseCards([]string{"H8", "HJ", "CK"})
+	analysis, err := Outs(hole, board)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if analysis.Street != "turn" || analysis.Unseen != 47 {
+		t.Errorf("Expected 47 unseen cards before the turn, got %s %d", analysis.Street, analysis.Unseen)
+	}
+	outs := float64(len(analysis.Outs))
+	if want := 1 - (47-outs)*(46-outs)/(47*46); math.Abs(analysis.Probability-want) > 1e-9 {
+		t.Errorf("Expected probability %f, got %f", want, analysis.Probability)
+	}
+	if math.Abs(analysis.RuleOfTwoAndFour-0.04*outs) > 1e-9 {
+		t.Errorf("Expected rule of four estimate %f, got %f", 0.04*outs, analysis.RuleOfTwoAndFour)
+	}
+
+	// A pair on the board is no out
+	board, _ = ParseCards([]string{"H8", "HJ", "CK", "D5"})
+	analysis, err = Outs(hole, board)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for _, out := range analysis.Outs {
+		if out.Card.Rank == 8 || out.Card.Rank == 11 || out.Card.Rank == 13 || out.Card.Rank == 5 {
+			if out.Card.Suit != "H" {
+				t.Errorf("Expected pairing the board not to count, got %v", out)
+			}
+		}
+	}
+	if math.Abs(analysis.Probability-float64(len(analysis.Outs))/46) > 1e-9 {
+		t.Errorf("Expected outs/46 on the turn, got %f", analysis.Probability)
+	}
+}
+
+func TestOuts_Opponents(t *testing.T) {
+	draw, _ := ParseCards([]string{"HA", "HK"})
+	queens, _ := ParseCards([]string{"SQ", "CQ"})
+	board, _ := ParseCards([]string{"H2", "H7", "C9"})
+
+	analysis, err := Outs(draw, board, queens)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if analysis.Ahead || len(analysis.Outs) != 15 || analysis.Unseen != 45 {
+		t.Errorf("Expected 15 of 45 cards to put the hero ahead, got %d of %d (ahead %v)",
+			len(analysis.Outs), analysis.Unseen, analysis.Ahead)
+	}
+
+	analysis, err = Outs(queens, board, draw)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !analysis.Ahead || len(analysis.Outs) != 0 {
+		t.Errorf("Expected the queens to be ahead with no outs, got %+v", analysis)
+	}
+
+	if _, err := Omaha.Outs(draw, board); err == nil {
+		t.Error("Expected an error for Omaha")
+	}
+}
@@ -0,0 +1,118 @@
+package poker
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestCalculateWinProbabilityContext_Cancelled(t *testing.T) {
+	hole, _ := ParseCards([]string{"HA", "SA"})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	result, err := CalculateWinProbabilityContext(ctx, hole, nil, 2, 100000)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+	if result == nil || !result.Partial || result.Simulations != 0 {
+		t.Errorf("Expected an empty partial result, got %+v", result)
+	}
+}
+
+func TestCalculateWinProbabilityContext_Deadline(t *testing.T) {
+	hole, _ := ParseCards([]string{"HA", "SA"})
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	result, err := CalculateWinProbabilityContext(ctx, hole, nil, 9, 1<<30)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected context.DeadlineExceeded, got %v", err)
+	}
+	if !result.Partial || result.Simulations == 0 || result.Simulations%simulationChunk != 0 {
+		t.Errorf("Expected whole chunks of partial results, got %+v", result)
+	}
+	total := result.WinProbability + result.TieProbability + result.LossProbability
+	if total < 0.999 || total > 1.001 {
+		t.Errorf("Expected probabilities to sum to 1, got %f", total)
+	}
+}
+
+func TestCalculateWinProbability_UnevenChunks(t *testing.T) {
+	hole, _ := ParseCards([]string{"HA", "SA"})
+	result, err := CalculateWinProbability(hole, nil, 3, 2*simulationChunk+7)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.Simulations != 2*simulationChunk+7 || result.Partial {
+		t.Errorf("Expected %d complete simulations, got %+v", 2*simulationChunk+7, result)
+	}
+}
+
+func TestCalculateWinProbability_SeedReproducible(t *testing.T) {
+	hole, _ := ParseCards([]string{"HA", "SK"})
+	board, _ := ParseCards([]string{"H7", "D8", "C2"})
+	omahaHole, _ := ParseCards([]string{"HA", "S2", "D3", "CK"})
+	villain, _ := ParseRange("QQ+, AK")
+	tests := []struct {
+		name string
+		hole []Card
+		opts []ProbabilityOption
+	}{
+		{"holdem", hole, nil},
+		{"ranges", hole, []ProbabilityOption{WithOpponentRanges(villain, nil)}},
+		{"omaha8", omahaHole, []ProbabilityOption{WithGame(Omaha8)}},
+	}
+
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(0))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := append([]ProbabilityOption{WithSeed(42)}, tt.opts...)
+			var first *ProbabilityResult
+			for _, procs := range []int{1, 3, 8} {
+				runtime.GOMAXPROCS(procs)
+				result, err := CalculateWinProbability(tt.hole, board, 3, 5*simulationChunk+123, opts...)
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				if result.Seed != 42 {
+					t.Errorf("Expected seed 42 to be echoed, got %d", result.Seed)
+				}
+				if first == nil {
+					first = result
+				} else if !reflect.DeepEqual(result, first) {
+					t.Errorf("GOMAXPROCS=%d: Expected %+v, got %+v", procs, first, result)
+				}
+			}
+
+			other, err := CalculateWinProbability(tt.hole, board, 3, 5*simulationChunk+123,
+				append([]ProbabilityOption{WithSeed(43)}, tt.opts...)...)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if reflect.DeepEqual(other, first) {
+				t.Errorf("Expected a different seed to give a different run, got %+v", other)
+			}
+		})
+	}
+}
+
+func TestCalculateEquity_SeedReproducible(t *testing.T) {
+	aces, _ := ParseCards([]string{"HA", "SA"})
+	kings, _ := ParseCards([]string{"DK", "CK"})
+	holes := [][]Card{aces, kings}
+	first, err := CalculateEquity(holes, nil, 2000, WithSeed(7))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	second, err := CalculateEquity(holes, nil, 2000, WithSeed(7))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if first.Seed != 7 || !reflect.DeepEqual(first, second) {
+		t.Errorf("Expected identical runs for seed 7, got %+v and %+v", first, second)
+	}
+}
@@ -0,0 +1,87 @@
+package poker
+
+import (
+	"fmt"
+	"math"
+)
+
+// z95 is the normal quantile of a two-sided 95% confidence interval
+const z95 = 1.959963984540054
+
+// precisionBatch is the number of hands simulated between precision checks
+// when a target precision is set. Checking after a fixed number of hands,
+// rather than after whatever the workers have finished, keeps seeded runs
+// reproducible.
+const precisionBatch = 10 * simulationChunk
+
+// SamplingError describes the uncertainty of one simulated probability
+type SamplingError struct {
+	StdErr float64    `json:"stdErr"` // standard error of the estimate
+	CI95   [2]float64 `json:"ci95"`   // 95% confidence interval (Wilson score)
+}
+
+// Precision holds the sampling error of each probability in a simulated
+// result
+type Precision struct {
+	Win  SamplingError `json:"win"`
+	Tie  SamplingError `json:"tie"`
+	Loss SamplingError `json:"loss"`
+}
+
+// Width returns the width of the widest of the three confidence intervals
+func (p *Precision) Width() float64 {
+	width := 0.0
+	for _, e := range []SamplingError{p.Win, p.Tie, p.Loss} {
+		width = max(width, e.CI95[1]-e.CI95[0])
+	}
+	return width
+}
+
+// WithTargetPrecision keeps a Monte Carlo calculation simulating until the
+// 95% confidence intervals of the win, tie and loss probabilities are all
+// at most width wide. The number of simulations becomes a limit rather than
+// a fixed count, and the result reports how many hands were needed.
+func WithTargetPrecision(width float64) ProbabilityOption {
+	return func(c *probabilityConfig) {
+		c.precision = width
+	}
+}
+
+// checkPrecision validates a target precision; zero means none
+func checkPrecision(width float64) error {
+	if width < 0 || width >= 1 || math.IsNaN(width) {
+		return fmt.Errorf("target precision must be between 0 and 1")
+	}
+	return nil
+}
+
+// samplingError estimates the standard error and 95% confidence interval
+// of a proportion observed in n hands. The Wilson score interval is used
+// because it stays sensible for probabilities near 0 or 1, where a tie
+// probability usually lies.
+func samplingError(p, n float64) SamplingError {
+	if n == 0 {
+		return SamplingError{CI95: [2]float64{0, 1}}
+	}
+	z2 := z95 * z95
+	denom := 1 + z2/n
+	center := (p + z2/(2*n)) / denom
+	half := z95 / denom * math.Sqrt(p*(1-p)/n+z2/(4*n*n))
+	return SamplingError{
+		StdErr: math.Sqrt(p * (1 - p) / n),
+		CI95:   [2]float64{max(0, center-half), min(1, center+half)},
+	}
+}
+
+// precision computes the sampling error of a simulated result
+func (t *winTally) precision() *Precision {
+	n := t.wins + t.ties + t.losses
+	if n == 0 {
+		return &Precision{Win: samplingError(0, 0), Tie: samplingError(0, 0), Loss: samplingError(0, 0)}
+	}
+	return &Precision{
+		Win:  samplingError(t.wins/n, n),
+		Tie:  samplingError(t.ties/n, n),
+		Loss: samplingError(t.losses/n, n),
+	}
+}
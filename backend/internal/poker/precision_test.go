@@ -0,0 +1,93 @@
+package poker
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestSamplingError(t *testing.T) {
+	e := samplingError(0.5, 10000)
+	if math.Abs(e.StdErr-0.005) > 1e-12 {
+		t.Errorf("Expected standard error 0.005, got %f", e.StdErr)
+	}
+	if e.CI95[0] > 0.5 || e.CI95[1] < 0.5 || math.Abs(e.CI95[1]-e.CI95[0]-2*z95*0.005) > 1e-4 {
+		t.Errorf("Expected a 95%% interval of about 0.5 ± 0.0098, got %v", e.CI95)
+	}
+
+	// A probability never observed still has a non-empty interval
+	e = samplingError(0, 1000)
+	if e.StdErr != 0 || e.CI95[0] != 0 || e.CI95[1] <= 0 || e.CI95[1] > 0.01 {
+		t.Errorf("Expected an interval just above zero, got %+v", e)
+	}
+}
+
+func TestCalculateWinProbability_Precision(t *testing.T) {
+	hole, _ := ParseCards([]string{"HA", "SK"})
+	result, err := CalculateWinProbability(hole, nil, 2, 20000, WithSeed(1))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	p := result.Precision
+	if p == nil {
+		t.Fatal("Expected sampling errors for a simulation")
+	}
+	for name, e := range map[string]SamplingError{"win": p.Win, "tie": p.Tie, "loss": p.Loss} {
+		if e.StdErr <= 0 || e.StdErr > 0.004 || e.CI95[0] >= e.CI95[1] {
+			t.Errorf("Unexpected %s sampling error %+v", name, e)
+		}
+	}
+	if result.WinProbability < p.Win.CI95[0] || result.WinProbability > p.Win.CI95[1] {
+		t.Errorf("Expected win probability %f inside %v", result.WinProbability, p.Win.CI95)
+	}
+	if result.PrecisionReached {
+		t.Error("Expected no precision target without WithTargetPrecision")
+	}
+
+	board, _ := ParseCards([]string{"HK", "D7", "C2", "S3"})
+	exact, err := CalculateWinProbability(hole, board, 2, 0, WithMode(ExactMode))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if exact.Precision != nil {
+		t.Errorf("Expected no sampling error for an exact result, got %+v", exact.Precision)
+	}
+}
+
+func TestCalculateWinProbability_TargetPrecision(t *testing.T) {
+	hole, _ := ParseCards([]string{"HA", "SK"})
+	opts := []ProbabilityOption{WithSeed(3), WithTargetPrecision(0.02)}
+
+	result, err := CalculateWinProbability(hole, nil, 3, 1_000_000, opts...)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.PrecisionReached || result.Precision.Width() > 0.02 {
+		t.Errorf("Expected intervals at most 0.02 wide, got %+v", result.Precision)
+	}
+	if result.Simulations >= 1_000_000 || result.Simulations%precisionBatch != 0 {
+		t.Errorf("Expected to stop after a whole number of batches, got %d simulations", result.Simulations)
+	}
+
+	again, err := CalculateWinProbability(hole, nil, 3, 1_000_000, opts...)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(result, again) {
+		t.Errorf("Expected a seeded run to stop at the same point, got %+v and %+v", result, again)
+	}
+
+	// The limit wins when the target cannot be met in time
+	capped, err := CalculateWinProbability(hole, nil, 3, 15000, WithSeed(3), WithTargetPrecision(0.001))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if capped.PrecisionReached || capped.Simulations != 15000 {
+		t.Errorf("Expected to stop at the 15000 hand limit, got %d simulations (reached %v)",
+			capped.Simulations, capped.PrecisionReached)
+	}
+
+	if _, err := CalculateWinProbability(hole, nil, 3, 1000, WithTargetPrecision(1.5)); err == nil {
+		t.Error("Expected an error for a target precision above 1")
+	}
+}
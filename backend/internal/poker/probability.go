@@ -1,8 +1,12 @@
 package poker
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
+	"runtime"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -12,12 +16,116 @@ type ProbabilityResult struct {
 	TieProbability  float64 `json:"tieProbability"`
 	LossProbability float64 `json:"lossProbability"`
 	Simulations     int     `json:"simulations"`
+
+	Exact   bool  `json:"exact"`             // every deal was enumerated; Simulations counts them
+	Partial bool  `json:"partial,omitempty"` // the run was cancelled after Simulations hands
+	Seed    int64 `json:"seed"`              // seed of the simulation's random streams, 0 when exact
+
+	Counts *OutcomeCounts `json:"counts,omitempty"` // the exact fractions behind the probabilities; nil unless exact
+
+	Precision        *Precision `json:"precision,omitempty"`        // sampling error of a simulation; nil when exact
+	PrecisionReached bool       `json:"precisionReached,omitempty"` // the WithTargetPrecision width was met within the limit
+
+	Categories *CategoryBreakdown `json:"categories,omitempty"` // how often each hand category was made
+
+	HiLo *HiLoResult `json:"hiLo,omitempty"` // set for hi/lo games
+}
+
+// OutcomeCounts are the weighted deal counts of an exact result. Each
+// probability is its count over Total, so the counts give the probabilities
+// as exact fractions. Deals are weighted by range combo weights, so the
+// counts are whole numbers unless an opponent range has partial weights.
+type OutcomeCounts struct {
+	Wins   float64 `json:"wins"`
+	Ties   float64 `json:"ties"`
+	Losses float64 `json:"losses"`
+	Total  float64 `json:"total"`
+}
+
+// HiLoResult breaks down a hi/lo simulation by the part of the pot the hero
+// won. In hi/lo games a win is a scoop and a tie is any partial share, so
+// the buckets add up to the win and tie probabilities.
+type HiLoResult struct {
+	ScoopProbability        float64 `json:"scoopProbability"`        // the whole pot
+	HighOnlyProbability     float64 `json:"highOnlyProbability"`     // all of the high half, none of the low half
+	LowOnlyProbability      float64 `json:"lowOnlyProbability"`      // all of the low half, none of the high half
+	ThreeQuarterProbability float64 `json:"threeQuarterProbability"` // all of one half and a split share of the other
+	QuarteredProbability    float64 `json:"quarteredProbability"`    // split shares only, of either half or both
+	SplitProbability        float64 `json:"splitProbability"`        // a split share of a pot with no qualifying low
+}
+
+// ProbabilityOption customises a win probability calculation
+type ProbabilityOption func(*probabilityConfig)
+
+// probabilityConfig collects the settings applied by ProbabilityOptions
+type probabilityConfig struct {
+	game   Game
+	ranges []*Range
+	mode   Mode
+	seed   int64
+	seeded bool
+
+	precision float64 // target confidence interval width, or 0
+}
+
+// runSeed returns the seed chosen with WithSeed, or a fresh one
+func (c *probabilityConfig) runSeed() int64 {
+	if c.seeded {
+		return c.seed
+	}
+	return time.Now().UnixNano()
+}
+
+// WithGame selects the variant to simulate. The default is Hold'em.
+func WithGame(game Game) ProbabilityOption {
+	return func(c *probabilityConfig) {
+		c.game = game
+	}
+}
+
+// WithSeed fixes the seed of the random streams, so that the same seed and
+// inputs always give the same result however the work is scheduled
+func WithSeed(seed int64) ProbabilityOption {
+	return func(c *probabilityConfig) {
+		c.seed = seed
+		c.seeded = true
+	}
+}
+
+// WithOpponentRanges deals each opponent a hand from their range instead of
+// a random one. There must be one range per opponent; a nil range deals that
+// opponent a random hand. Ranges need a game with two hole cards.
+func WithOpponentRanges(ranges ...*Range) ProbabilityOption {
+	return func(c *probabilityConfig) {
+		c.ranges = ranges
+	}
+}
+
+// CalculateWinProbability calculates the probability of winning. By default
+// it runs numSimulations Monte Carlo hands; WithMode(ExactMode) enumerates
+// every possible deal instead, and WithMode(AutoMode) enumerates when there
+// are at most ExactThreshold deals and simulates otherwise. Exact results are
+// marked Exact and ignore numSimulations.
+func CalculateWinProbability(holeCards []Card, communityCards []Card, numPlayers int, numSimulations int, opts ...ProbabilityOption) (*ProbabilityResult, error) {
+	return CalculateWinProbabilityContext(context.Background(), holeCards, communityCards, numPlayers, numSimulations, opts...)
 }
 
-// CalculateWinProbability calculates the probability of winning using Monte Carlo simulation
-func CalculateWinProbability(holeCards []Card, communityCards []Card, numPlayers int, numSimulations int) (*ProbabilityResult, error) {
-	if len(holeCards) != 2 {
-		return nil, fmt.Errorf("must have exactly 2 hole cards")
+// CalculateWinProbabilityContext is CalculateWinProbability with the
+// simulations spread over GOMAXPROCS workers. When ctx is done the run stops
+// and returns the hands simulated so far, marked Partial, together with
+// ctx.Err(). An exact enumeration cut short returns no result.
+func CalculateWinProbabilityContext(ctx context.Context, holeCards []Card, communityCards []Card, numPlayers int, numSimulations int, opts ...ProbabilityOption) (*ProbabilityResult, error) {
+	config := probabilityConfig{game: Holdem, mode: MonteCarloMode}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	game := config.game
+
+	if len(holeCards) != game.HoleCards() {
+		return nil, fmt.Errorf("must have exactly %d hole cards", game.HoleCards())
+	}
+	if !game.HasBoard() {
+		return nil, fmt.Errorf("%s has no community cards", game)
 	}
 	if len(communityCards) > 5 {
 		return nil, fmt.Errorf("cannot have more than 5 community cards")
@@ -25,130 +133,462 @@ func CalculateWinProbability(holeCards []Card, communityCards []Card, numPlayers
 	if numPlayers < 2 || numPlayers > 10 {
 		return nil, fmt.Errorf("number of players must be between 2 and 10")
 	}
+	if numPlayers*game.HoleCards()+5 > game.Deck().Count() {
+		return nil, fmt.Errorf("not enough cards to deal %s to %d players", game, numPlayers)
+	}
+	if err := checkPrecision(config.precision); err != nil {
+		return nil, err
+	}
+	if len(config.ranges) > 0 {
+		if len(config.ranges) != numPlayers-1 {
+			return nil, fmt.Errorf("need one range per opponent: got %d ranges for %d opponents",
+				len(config.ranges), numPlayers-1)
+		}
+		if game.HoleCards() != 2 {
+			return nil, fmt.Errorf("ranges need a game with two hole cards, not %s", game)
+		}
+	}
+
+	// Create a deck and remove known cards
+	known := append(append([]Card{}, holeCards...), communityCards...)
+	if HasDuplicates(known) {
+		return nil, fmt.Errorf("duplicate cards detected")
+	}
+	if NewCardSet(known...).Remove(game.Deck()) != 0 {
+		return nil, fmt.Errorf("cards must come from the %s deck", game)
+	}
+	deck := game.Deck().Remove(NewCardSet(known...)).IDs()
+
+	// Opponents with a range are dealt from it; the rest get random hands
+	var ranged []*Range
+	for _, r := range config.ranges {
+		if r != nil {
+			ranged = append(ranged, r)
+		}
+	}
+	var sampler *rangeSampler
+	if len(ranged) > 0 {
+		var err error
+		excluded := NewCardSet(known...) | FullDeck.Remove(game.Deck())
+		sampler, err = newRangeSampler(ranged, excluded)
+		if err != nil {
+			return nil, err
+		}
+	}
+	numRandom := numPlayers - 1 - len(ranged)
+
+	hero := make([]CardID, len(holeCards))
+	for i, card := range holeCards {
+		hero[i] = card.ID()
+	}
+	var board [5]CardID
+	for i, card := range communityCards {
+		board[i] = card.ID()
+	}
+
+	// Enumerate every deal when asked to, or when there are few enough
+	holeCount := game.HoleCards()
+	size := enumerationSize(len(deck), sampler, numRandom, holeCount, 5-len(communityCards))
+	exact, err := config.mode.useExact(size)
+	if err != nil {
+		return nil, err
+	}
+	if exact {
+		var tally winTally
+		enumerateDeals(deck, sampler, numRandom, holeCount, board, len(communityCards),
+			func(opponents []CardID, board [5]CardID, weight float64) bool {
+				if game.IsHiLo() {
+					highShare, lowShare, hasLow, categories := heroHiLoShares(game, hero, board, opponents)
+					tally.addHiLo(classifyHiLo(highShare, lowShare, hasLow), categories, weight)
+				} else {
+					result, categories := heroResult(game, hero, board, opponents, nil)
+					tally.add(result, categories, weight)
+				}
+				return tally.hands%4096 != 0 || ctx.Err() == nil
+			})
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		result := tally.result(game)
+		result.Exact = true
+		result.Counts = &OutcomeCounts{
+			Wins:   tally.wins,
+			Ties:   tally.ties,
+			Losses: tally.losses,
+			Total:  tally.wins + tally.ties + tally.losses,
+		}
+		return result, nil
+	}
 	if numSimulations < 1 {
 		return nil, fmt.Errorf("number of simulations must be at least 1")
 	}
 
-	// Create a deck and remove known cards
-	usedCards := make(map[string]bool)
-	for _, card := range holeCards {
-		usedCards[fmt.Sprintf("%d%s", card.Rank, card.Suit)] = true
-	}
-	for _, card := range communityCards {
-		usedCards[fmt.Sprintf("%d%s", card.Rank, card.Suit)] = true
-	}
-
-	// Build available deck
-	availableDeck := []Card{}
-	suits := []string{"H", "D", "C", "S"}
-	for _, suit := range suits {
-		for rank := 2; rank <= 14; rank++ {
-			key := fmt.Sprintf("%d%s", rank, suit)
-			if !usedCards[key] {
-				availableDeck = append(availableDeck, Card{Rank: rank, Suit: suit})
+	// Each worker deals from its own copy of the deck, restored to the same
+	// order at the start of every chunk so that a chunk's hands depend only
+	// on its random stream
+	newWorker := func() func(rng *rand.Rand, n int, tally *winTally) error {
+		chunkDeck := make([]CardID, len(deck))
+		var fixed []CardID
+		live := make([]CardID, 0, len(deck))
+		return func(rng *rand.Rand, n int, tally *winTally) error {
+			copy(chunkDeck, deck)
+			for i := 0; i < n; i++ {
+				switch {
+				case sampler != nil:
+					var dealt CardSet
+					var ok bool
+					if fixed, dealt, ok = sampler.deal(rng, fixed[:0]); !ok {
+						return fmt.Errorf("opponent ranges overlap too much to deal every hand")
+					}
+					live = live[:0]
+					for _, id := range chunkDeck {
+						if dealt&cardBit(id) == 0 {
+							live = append(live, id)
+						}
+					}
+					result, categories := simulateHand(game, hero, board, len(communityCards), fixed, live, numRandom, rng)
+					tally.add(result, categories, 1)
+				case game.IsHiLo():
+					highShare, lowShare, hasLow, categories := simulateHiLoHand(game, hero, board, len(communityCards), chunkDeck, numPlayers-1, rng)
+					tally.addHiLo(classifyHiLo(highShare, lowShare, hasLow), categories, 1)
+				default:
+					result, categories := simulateHand(game, hero, board, len(communityCards), nil, chunkDeck, numPlayers-1, rng)
+					tally.add(result, categories, 1)
+				}
 			}
+			return nil
 		}
 	}
 
-	wins := 0
-	ties := 0
-	losses := 0
+	// With a target precision, simulate in fixed batches until the
+	// confidence intervals are narrow enough or the limit is reached
+	seed := config.runSeed()
+	batch := numSimulations
+	if config.precision > 0 {
+		batch = precisionBatch
+	}
+	tally := &winTally{}
+	reached := false
+	for tally.hands < numSimulations && !reached && err == nil {
+		var done *winTally
+		done, err = simulateChunks(ctx, tally.hands, min(batch, numSimulations-tally.hands), seed, newWorker)
+		if done == nil {
+			return nil, err
+		}
+		tally.merge(done)
+		reached = err == nil && config.precision > 0 && tally.precision().Width() <= config.precision
+	}
 
-	// Seed random number generator
-	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	result := tally.result(game)
+	result.Partial = err != nil
+	result.Seed = seed
+	result.Precision = tally.precision()
+	result.PrecisionReached = reached
+	return result, err
+}
 
-	// Run simulations
-	for sim := 0; sim < numSimulations; sim++ {
-		result := simulateHand(holeCards, communityCards, availableDeck, numPlayers, rng)
-		switch result {
-		case 1:
-			wins++
-		case 0:
-			ties++
-		case -1:
-			losses++
-		}
+// simulationChunk is the number of hands in one unit of parallel work.
+// Every chunk draws from its own random stream, seeded from the run's seed
+// and the chunk's index, so the result does not depend on which worker
+// simulates which chunk.
+const simulationChunk = 1000
+
+// simulateChunks simulates count hands, starting with hand first of the
+// run, in chunks spread over GOMAXPROCS workers and merges their tallies.
+// first must be a multiple of simulationChunk. newWorker is called once per
+// worker and returns a function that simulates the n hands of one chunk.
+// When ctx is done the workers stop after their current chunk and the
+// chunks completed so far are returned with ctx.Err().
+func simulateChunks(ctx context.Context, first, count int, seed int64,
+	newWorker func() func(rng *rand.Rand, n int, tally *winTally) error) (*winTally, error) {
+	firstChunk := first / simulationChunk
+	numChunks := (count + simulationChunk - 1) / simulationChunk
+	tallies := make([]*winTally, numChunks)
+	workers := min(runtime.GOMAXPROCS(0), numChunks)
+	errs := make([]error, workers)
+
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			simulate := newWorker()
+			for ctx.Err() == nil {
+				chunk := int(next.Add(1) - 1)
+				if chunk >= numChunks {
+					return
+				}
+				n := min(simulationChunk, count-chunk*simulationChunk)
+				rng := rand.New(rand.NewSource(chunkSeed(seed, firstChunk+chunk)))
+				tally := &winTally{}
+				if err := simulate(rng, n, tally); err != nil {
+					errs[w] = err
+					return
+				}
+				tallies[chunk] = tally
+			}
+		}(w)
 	}
+	wg.Wait()
 
-	total := float64(numSimulations)
-	return &ProbabilityResult{
-		WinProbability:  float64(wins) / total,
-		TieProbability:  float64(ties) / total,
-		LossProbability: float64(losses) / total,
-		Simulations:     numSimulations,
-	}, nil
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	total := &winTally{}
+	for _, tally := range tallies {
+		if tally != nil {
+			total.merge(tally)
+		}
+	}
+	return total, ctx.Err()
 }
 
-// simulateHand simulates one hand and returns 1 for win, 0 for tie, -1 for loss
-func simulateHand(holeCards []Card, communityCards []Card, availableDeck []Card, numPlayers int, rng *rand.Rand) int {
-	// Shuffle available deck
-	deck := make([]Card, len(availableDeck))
-	copy(deck, availableDeck)
-	shuffleDeck(deck, rng)
+// chunkSeed derives the seed of one chunk's random stream by mixing the
+// run's seed with the chunk index (the SplitMix64 finalizer)
+func chunkSeed(seed int64, chunk int) int64 {
+	z := uint64(seed) + uint64(chunk+1)*0x9E3779B97F4A7C15
+	z = (z ^ (z >> 30)) * 0xBF58476D1CE4E5B9
+	z = (z ^ (z >> 27)) * 0x94D049BB133111EB
+	return int64(z ^ (z >> 31))
+}
 
-	deckIdx := 0
+// simulateHand simulates one hand and returns 1 for win, 0 for tie, -1 for
+// loss, with the categories the hero and the best opponent finished with.
+// The first known entries of board are fixed, as are the hole cards of the
+// opponents already dealt in fixed. The rest of the board and numOpponents
+// further hands are dealt from the front of deck after partially shuffling
+// it in place, so no cards or hands are allocated.
+func simulateHand(game Game, hero []CardID, board [5]CardID, known int, fixed []CardID, deck []CardID, numOpponents int, rng *rand.Rand) (int, finalCategories) {
+	holeCount := game.HoleCards()
+	dealDeck(deck, 5-known+holeCount*numOpponents, rng)
 
 	// Complete community cards if needed
-	fullCommunity := make([]Card, len(communityCards))
-	copy(fullCommunity, communityCards)
-	cardsNeeded := 5 - len(communityCards)
-	for i := 0; i < cardsNeeded; i++ {
-		fullCommunity = append(fullCommunity, deck[deckIdx])
-		deckIdx++
-	}
+	deckIdx := copy(board[known:], deck)
+	return heroResult(game, hero, board, fixed, deck[deckIdx:deckIdx+holeCount*numOpponents])
+}
+
+// heroResult returns 1 if the hero beats every opponent on a complete board,
+// 0 for a tie at the top and -1 for a loss, with the categories the hero and
+// the best opponent finished with. fixed and dealt each list opponents' hole
+// cards back to back.
+func heroResult(game Game, hero []CardID, board [5]CardID, fixed []CardID, dealt []CardID) (int, finalCategories) {
+	holeCount := game.HoleCards()
 
 	// Evaluate player's hand
-	playerCards := append(holeCards, fullCommunity...)
-	playerHand, err := EvaluateHand(playerCards)
-	if err != nil {
-		return -1 // Should not happen
+	playerStrength := game.score(hero, board[:])
+
+	// Evaluate opponent hands
+	var bestOpponent uint16
+	for _, opponents := range [2][]CardID{fixed, dealt} {
+		for i := 0; i < len(opponents); i += holeCount {
+			if s := game.score(opponents[i:i+holeCount], board[:]); s > bestOpponent {
+				bestOpponent = s
+			}
+		}
 	}
+	categories := finalCategories{hero: game.category(playerStrength), opponent: game.category(bestOpponent)}
 
-	// Deal and evaluate opponent hands
-	opponentHands := make([]*Hand, numPlayers-1)
-	for i := 0; i < numPlayers-1; i++ {
-		opponentHoleCards := []Card{deck[deckIdx], deck[deckIdx+1]}
-		deckIdx += 2
+	// Player wins if they beat all opponents, ties if not beaten by any
+	switch {
+	case bestOpponent > playerStrength:
+		return -1, categories
+	case bestOpponent == playerStrength:
+		return 0, categories
+	}
+	return 1, categories
+}
 
-		opponentCards := append(opponentHoleCards, fullCommunity...)
-		opponentHand, err := EvaluateHand(opponentCards)
-		if err != nil {
-			return -1 // Should not happen
+// simulateHiLoHand simulates one hi/lo hand and returns the hero's share of
+// the high half and of the low half, whether any hand qualified for low and
+// the high hand categories of the hero and the best opponent
+func simulateHiLoHand(game Game, hero []CardID, board [5]CardID, known int, deck []CardID, numOpponents int, rng *rand.Rand) (highShare, lowShare float64, hasLow bool, categories finalCategories) {
+	holeCount := game.HoleCards()
+	dealDeck(deck, 5-known+holeCount*numOpponents, rng)
+	deckIdx := copy(board[known:], deck)
+	return heroHiLoShares(game, hero, board, deck[deckIdx:deckIdx+holeCount*numOpponents])
+}
+
+// heroHiLoShares returns the hero's share of each half of a hi/lo pot on a
+// complete board against opponents' hole cards listed back to back, and the
+// high hand categories of the hero and the best opponent
+func heroHiLoShares(game Game, hero []CardID, board [5]CardID, opponents []CardID) (highShare, lowShare float64, hasLow bool, categories finalCategories) {
+	holeCount := game.HoleCards()
+	var highs, lows [10]uint16
+	highs[0] = game.score(hero, board[:])
+	lows[0] = omahaLowScore(hero, board[:])
+	n := 1
+	for i := 0; i < len(opponents); i += holeCount {
+		highs[n] = game.score(opponents[i:i+holeCount], board[:])
+		lows[n] = omahaLowScore(opponents[i:i+holeCount], board[:])
+		n++
+	}
+	categories = finalCategories{hero: game.category(highs[0]), opponent: game.category(maxScore(highs[1:n]))}
+	return heroShare(highs[:n]), heroShare(lows[:n]), maxScore(lows[:n]) > 0, categories
+}
+
+// heroShare returns the fraction of a pot the hero (scores[0]) wins when the
+// highest non-zero scores split it
+func heroShare(scores []uint16) float64 {
+	best := maxScore(scores)
+	if best == 0 || scores[0] != best {
+		return 0
+	}
+	count := 0
+	for _, s := range scores {
+		if s == best {
+			count++
 		}
-		opponentHands[i] = opponentHand
 	}
+	return 1 / float64(count)
+}
 
-	// Determine result
-	wins := 0
-	ties := 0
+// maxScore returns the highest score
+func maxScore(scores []uint16) uint16 {
+	best := uint16(0)
+	for _, s := range scores {
+		if s > best {
+			best = s
+		}
+	}
+	return best
+}
+
+// hiLoOutcome is the part of a hi/lo pot the hero won
+type hiLoOutcome int
+
+const (
+	hiLoNothing      hiLoOutcome = iota
+	hiLoScoop                    // the whole pot
+	hiLoHighOnly                 // all of the high half, none of the low half
+	hiLoLowOnly                  // all of the low half, none of the high half
+	hiLoThreeQuarter             // all of one half and a split share of the other
+	hiLoQuartered                // split shares only, of either half or both
+	hiLoSplit                    // a share of a pot with no qualifying low
+)
 
-	for _, opponentHand := range opponentHands {
-		cmp := playerHand.Compare(opponentHand)
-		if cmp > 0 {
-			wins++
-		} else if cmp == 0 {
-			ties++
+// classifyHiLo names the hero's part of a hi/lo pot from their shares
+func classifyHiLo(highShare, lowShare float64, hasLow bool) hiLoOutcome {
+	if !hasLow {
+		switch {
+		case highShare == 1:
+			return hiLoScoop
+		case highShare > 0:
+			return hiLoSplit
 		}
+		return hiLoNothing
 	}
 
-	// Player wins if they beat all opponents
-	if wins == len(opponentHands) {
-		return 1
+	switch {
+	case highShare == 1 && lowShare == 1:
+		return hiLoScoop
+	case highShare == 1 && lowShare == 0:
+		return hiLoHighOnly
+	case highShare == 0 && lowShare == 1:
+		return hiLoLowOnly
+	case (highShare == 1 && lowShare > 0) || (lowShare == 1 && highShare > 0):
+		return hiLoThreeQuarter
+	case highShare > 0 || lowShare > 0:
+		return hiLoQuartered
 	}
+	return hiLoNothing
+}
 
-	// Tie if tied with at least one opponent and not beaten by any
-	if ties > 0 && wins+ties == len(opponentHands) {
-		return 0
+// winTally accumulates the hero's results, each hand counted by its weight.
+// In hi/lo games a win is a scoop and a tie is any partial share.
+type winTally struct {
+	wins, ties, losses               float64
+	scoops, highOnly, lowOnly        float64
+	threeQuarters, quartered, splits float64
+	hands                            int
+	categories                       categoryTally
+}
+
+// add records one hand that the hero won (1), tied (0) or lost (-1) and the
+// categories the hero and the best opponent finished with
+func (t *winTally) add(result int, categories finalCategories, weight float64) {
+	switch result {
+	case 1:
+		t.wins += weight
+	case 0:
+		t.ties += weight
+	default:
+		t.losses += weight
 	}
+	t.categories.add(result, categories, weight)
+	t.hands++
+}
+
+// merge adds another tally's hands to this one
+func (t *winTally) merge(other *winTally) {
+	t.wins += other.wins
+	t.ties += other.ties
+	t.losses += other.losses
+	t.scoops += other.scoops
+	t.highOnly += other.highOnly
+	t.lowOnly += other.lowOnly
+	t.threeQuarters += other.threeQuarters
+	t.quartered += other.quartered
+	t.splits += other.splits
+	t.hands += other.hands
+	t.categories.merge(&other.categories)
+}
 
-	// Otherwise loss
-	return -1
+// addHiLo records one hi/lo hand and the high hand categories
+func (t *winTally) addHiLo(outcome hiLoOutcome, categories finalCategories, weight float64) {
+	switch outcome {
+	case hiLoScoop:
+		t.scoops += weight
+		t.add(1, categories, weight)
+		return
+	case hiLoHighOnly:
+		t.highOnly += weight
+	case hiLoLowOnly:
+		t.lowOnly += weight
+	case hiLoThreeQuarter:
+		t.threeQuarters += weight
+	case hiLoQuartered:
+		t.quartered += weight
+	case hiLoSplit:
+		t.splits += weight
+	case hiLoNothing:
+		t.add(-1, categories, weight)
+		return
+	}
+	t.add(0, categories, weight)
+}
+
+// result converts the tally into probabilities
+func (t *winTally) result(game Game) *ProbabilityResult {
+	result := &ProbabilityResult{Simulations: t.hands}
+	total := t.wins + t.ties + t.losses
+	if total == 0 {
+		return result
+	}
+	result.WinProbability = t.wins / total
+	result.TieProbability = t.ties / total
+	result.LossProbability = t.losses / total
+	result.Categories = t.categories.breakdown(game, total)
+	if game.IsHiLo() {
+		result.HiLo = &HiLoResult{
+			ScoopProbability:        t.scoops / total,
+			HighOnlyProbability:     t.highOnly / total,
+			LowOnlyProbability:      t.lowOnly / total,
+			ThreeQuarterProbability: t.threeQuarters / total,
+			QuarteredProbability:    t.quartered / total,
+			SplitProbability:        t.splits / total,
+		}
+	}
+	return result
 }
 
-// shuffleDeck shuffles the deck in place using Fisher-Yates algorithm
-func shuffleDeck(deck []Card, rng *rand.Rand) {
-	for i := len(deck) - 1; i > 0; i-- {
-		j := rng.Intn(i + 1)
+// dealDeck moves n uniformly random cards to the front of the deck using a
+// partial Fisher-Yates shuffle
+func dealDeck(deck []CardID, n int, rng *rand.Rand) {
+	for i := 0; i < n; i++ {
+		j := i + rng.Intn(len(deck)-i)
 		deck[i], deck[j] = deck[j], deck[i]
 	}
 }
@@ -0,0 +1,305 @@
+package poker
+
+import (
+	"fmt"
+	"math/bits"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Combo is one two-card starting hand in a range
+type Combo struct {
+	Cards  [2]Card
+	Weight float64 // share of the combo's deals the range plays, in (0, 1]
+}
+
+// Range is a weighted set of two-card starting hands, written in the usual
+// notation: "AKs", "TT+", "A2s-A5s", "KQo", "JJ-88", "AhKd", "random", with
+// entries separated by commas or spaces and an optional weight such as
+// "AKs:0.5". A later entry overrides the weight of combos listed earlier.
+type Range struct {
+	weights map[CardSet]float64 // keyed by the combo's two-card set
+	order   []CardSet           // combos in the order they were first listed
+}
+
+// ParseRange parses a range such as "TT+, AQs+, KQo:0.5"
+func ParseRange(s string) (*Range, error) {
+	r := &Range{weights: make(map[CardSet]float64)}
+	entries := strings.FieldsFunc(s, func(c rune) bool {
+		return c == ',' || c == ' ' || c == '\t' || c == '\n'
+	})
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("empty range")
+	}
+
+	for _, entry := range entries {
+		hands, weight := entry, 1.0
+		if i := strings.IndexByte(entry, ':'); i >= 0 {
+			hands = entry[:i]
+			w, err := strconv.ParseFloat(entry[i+1:], 64)
+			if err != nil || w < 0 || w > 1 {
+				return nil, fmt.Errorf("invalid weight in %s: must be between 0 and 1", entry)
+			}
+			weight = w
+		}
+		combos, err := parseRangeEntry(hands)
+		if err != nil {
+			return nil, err
+		}
+		for _, combo := range combos {
+			if _, ok := r.weights[combo]; !ok {
+				r.order = append(r.order, combo)
+			}
+			r.weights[combo] = weight
+		}
+	}
+	return r, nil
+}
+
+// Combos returns the range's combos, leaving out any that hold a dead card
+// (the board, the hero's hole cards)
+func (r *Range) Combos(dead ...Card) []Combo {
+	sets, weights := r.live(NewCardSet(dead...))
+	combos := make([]Combo, len(sets))
+	for i, cs := range sets {
+		cards := SortCards(cs.Cards())
+		combos[i] = Combo{Cards: [2]Card{cards[0], cards[1]}, Weight: weights[i]}
+	}
+	return combos
+}
+
+// live returns the two-card sets and weights of the combos that hold none
+// of the dead cards
+func (r *Range) live(dead CardSet) ([]CardSet, []float64) {
+	var sets []CardSet
+	var weights []float64
+	for _, cs := range r.order {
+		if w := r.weights[cs]; w > 0 && cs&dead == 0 {
+			sets = append(sets, cs)
+			weights = append(weights, w)
+		}
+	}
+	return sets, weights
+}
+
+// Count returns the number of combos in the range that hold no dead card
+func (r *Range) Count(dead ...Card) int {
+	return len(r.Combos(dead...))
+}
+
+// WeightedCount returns the combos that hold no dead card, each counted by
+// its weight
+func (r *Range) WeightedCount(dead ...Card) float64 {
+	total := 0.0
+	for _, combo := range r.Combos(dead...) {
+		total += combo.Weight
+	}
+	return total
+}
+
+// parseRangeEntry expands one range entry without its weight
+func parseRangeEntry(s string) ([]CardSet, error) {
+	switch strings.ToLower(s) {
+	case "random", "any", "100%":
+		var combos []CardSet
+		forEachCombination(NumCards, 2, func(idx []int) bool {
+			combos = append(combos, cardBit(CardID(idx[0]))|cardBit(CardID(idx[1])))
+			return true
+		})
+		return combos, nil
+	}
+
+	// A specific combo such as "AhKd"
+	if cards, err := ParseCardString(s); err == nil && len(cards) == 2 {
+		if cards[0] == cards[1] {
+			return nil, fmt.Errorf("invalid range entry %s: duplicate card", s)
+		}
+		return []CardSet{NewCardSet(cards...)}, nil
+	}
+
+	// A span such as "JJ-88" or "A2s-A5s"
+	if i := strings.IndexByte(s, '-'); i >= 0 {
+		from, err := parseHandClass(s[:i])
+		if err != nil {
+			return nil, err
+		}
+		to, err := parseHandClass(s[i+1:])
+		if err != nil {
+			return nil, err
+		}
+		if from.plus || to.plus || from.suited != to.suited {
+			return nil, fmt.Errorf("invalid range entry %s", s)
+		}
+		if from.isPair() != to.isPair() || (!from.isPair() && from.high != to.high) {
+			return nil, fmt.Errorf("invalid range entry %s: span must keep the top card", s)
+		}
+		lo, hi := from.low, to.low
+		if lo > hi {
+			lo, hi = hi, lo
+		}
+		var combos []CardSet
+		for rank := lo; rank <= hi; rank++ {
+			class := from
+			class.low = rank
+			if from.isPair() {
+				class.high = rank
+			}
+			combos = append(combos, class.combos()...)
+		}
+		return combos, nil
+	}
+
+	class, err := parseHandClass(s)
+	if err != nil {
+		return nil, err
+	}
+	if !class.plus {
+		return class.combos(), nil
+	}
+
+	// "TT+" climbs to aces, "A2s+" climbs the kicker to just below the top card
+	var combos []CardSet
+	top := class.high - 1
+	if class.isPair() {
+		top = 14
+	}
+	for rank := class.low; rank <= top; rank++ {
+		c := class
+		c.low = rank
+		if class.isPair() {
+			c.high = rank
+		}
+		combos = append(combos, c.combos()...)
+	}
+	return combos, nil
+}
+
+// handClass is a starting hand shape such as "AKs", "KQo", "TT" or "A5+"
+type handClass struct {
+	high, low int
+	suited    byte // 's', 'o', or 0 for both
+	plus      bool
+}
+
+func (c handClass) isPair() bool {
+	return c.high == c.low
+}
+
+// parseHandClass parses a shape such as "AKs", "QJo+", "77" or "T9"
+func parseHandClass(s string) (handClass, error) {
+	runes := []rune(strings.TrimSpace(s))
+	var class handClass
+	if len(runes) > 0 && runes[len(runes)-1] == '+' {
+		class.plus = true
+		runes = runes[:len(runes)-1]
+	}
+	if len(runes) > 0 {
+		switch runes[len(runes)-1] {
+		case 's', 'S':
+			class.suited = 's'
+			runes = runes[:len(runes)-1]
+		case 'o', 'O':
+			class.suited = 'o'
+			runes = runes[:len(runes)-1]
+		}
+	}
+
+	first, n := parseRank(runes)
+	if n == 0 {
+		return handClass{}, fmt.Errorf("invalid range entry %s", s)
+	}
+	second, m := parseRank(runes[n:])
+	if m == 0 || n+m != len(runes) {
+		return handClass{}, fmt.Errorf("invalid range entry %s", s)
+	}
+	class.high, class.low = first, second
+	if class.low > class.high {
+		class.high, class.low = class.low, class.high
+	}
+	if class.isPair() && class.suited != 0 {
+		return handClass{}, fmt.Errorf("invalid range entry %s: pairs cannot be suited or offsuit", s)
+	}
+	return class, nil
+}
+
+// combos lists the two-card sets of the class, ignoring plus
+func (c handClass) combos() []CardSet {
+	var combos []CardSet
+	for s1 := 0; s1 < 4; s1++ {
+		for s2 := 0; s2 < 4; s2++ {
+			if c.isPair() && s2 <= s1 {
+				continue
+			}
+			if (c.suited == 's' && s1 != s2) || (c.suited == 'o' && s1 == s2) {
+				continue
+			}
+			high := CardID(s1*13 + c.high - 2)
+			low := CardID(s2*13 + c.low - 2)
+			combos = append(combos, cardBit(high)|cardBit(low))
+		}
+	}
+	return combos
+}
+
+// maxRangeDealAttempts bounds the retries when sampled range combos collide
+const maxRangeDealAttempts = 10000
+
+// rangeSampler deals each ranged opponent a combo from their range. Combos
+// are drawn by weight and a deal in which two opponents share a card is
+// redrawn as a whole, so every deal is equally likely given the card removal.
+type rangeSampler struct {
+	combos     [][]CardSet // per opponent, the combos that avoid the known cards
+	weights    [][]float64 // per opponent, the weight of each combo
+	cumulative [][]float64 // per opponent, running totals of the combo weights
+}
+
+// newRangeSampler prepares the ranges for dealing around the excluded cards
+func newRangeSampler(ranges []*Range, excluded CardSet) (*rangeSampler, error) {
+	s := &rangeSampler{}
+	for i, r := range ranges {
+		combos, weights := r.live(excluded)
+		if len(combos) == 0 {
+			return nil, fmt.Errorf("range for opponent %d has no combos left after card removal", i+1)
+		}
+		cumulative := make([]float64, len(weights))
+		total := 0.0
+		for j, w := range weights {
+			total += w
+			cumulative[j] = total
+		}
+		s.combos = append(s.combos, combos)
+		s.weights = append(s.weights, weights)
+		s.cumulative = append(s.cumulative, cumulative)
+	}
+	return s, nil
+}
+
+// deal draws one combo per opponent and appends their card IDs to hands,
+// two per opponent in order. It returns the cards dealt, or false when no
+// deal without overlapping cards turns up.
+func (s *rangeSampler) deal(rng *rand.Rand, hands []CardID) ([]CardID, CardSet, bool) {
+	for attempt := 0; attempt < maxRangeDealAttempts; attempt++ {
+		var dealt CardSet
+		start := len(hands)
+		for i, combos := range s.combos {
+			cumulative := s.cumulative[i]
+			j := sort.SearchFloat64s(cumulative, rng.Float64()*cumulative[len(cumulative)-1])
+			if j == len(combos) {
+				j--
+			}
+			if combos[j]&dealt != 0 {
+				break
+			}
+			dealt |= combos[j]
+			hands = append(hands, CardID(bits.TrailingZeros64(uint64(combos[j]))),
+				CardID(63-bits.LeadingZeros64(uint64(combos[j]))))
+		}
+		if len(hands)-start == 2*len(s.combos) {
+			return hands, dealt, true
+		}
+		hands = hands[:start]
+	}
+	return hands, 0, false
+}
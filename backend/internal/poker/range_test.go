@@ -0,0 +1,133 @@
+package poker
+
+import (
+	"testing"
+)
+
+func TestParseRange_Counts(t *testing.T) {
+	tests := []struct {
+		spec     string
+		expected int
+	}{
+		{"AA", 6},
+		{"AKs", 4},
+		{"AKo", 12},
+		{"AK", 16},
+		{"TT+", 30},
+		{"JJ-88", 24},
+		{"88-JJ", 24},
+		{"A2s-A5s", 16},
+		{"A2s+", 48},
+		{"KQo", 12},
+		{"AhKd", 1},
+		{"random", 1326},
+		{"AA, KK, AKs", 16},
+		{"AA AA", 6},
+		{"T9s 98s", 8},
+		{"22+", 78},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			r, err := ParseRange(tt.spec)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got := r.Count(); got != tt.expected {
+				t.Errorf("Expected %d combos, got %d", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestParseRange_Invalid(t *testing.T) {
+	for _, spec := range []string{"", "AAs", "AKx", "A1s", "JJ-A5s", "A2s-K5s", "A2s-A5o", "AK:2", "AK:x", "AhAh"} {
+		if _, err := ParseRange(spec); err == nil {
+			t.Errorf("Expected error for %q", spec)
+		}
+	}
+}
+
+func TestRange_DeadCards(t *testing.T) {
+	r, _ := ParseRange("AA, AKs")
+	dead, _ := ParseCards([]string{"HA", "S2"})
+
+	// Three aces leave 3 pairs of aces and three suited AK
+	if got := r.Count(dead...); got != 6 {
+		t.Errorf("Expected 6 combos, got %d", got)
+	}
+	for _, combo := range r.Combos(dead...) {
+		if combo.Cards[0] == dead[0] || combo.Cards[1] == dead[0] {
+			t.Errorf("Combo %v holds a dead card", combo.Cards)
+		}
+		if combo.Cards[0].Rank < combo.Cards[1].Rank {
+			t.Errorf("Expected the higher card first, got %v", combo.Cards)
+		}
+	}
+}
+
+func TestRange_Weights(t *testing.T) {
+	r, err := ParseRange("QQ+, AKs:0.5, KK:0")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := r.Count(); got != 16 {
+		t.Errorf("Expected KK to be removed leaving 16 combos, got %d", got)
+	}
+	if got := r.WeightedCount(); got != 14 {
+		t.Errorf("Expected a weighted count of 14, got %f", got)
+	}
+}
+
+func TestCalculateWinProbability_OpponentRanges(t *testing.T) {
+	hole, _ := ParseCards([]string{"HA", "SA"})
+
+	kings, _ := ParseRange("KK")
+	result, err := CalculateWinProbability(hole, nil, 2, 20000, WithOpponentRanges(kings))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.WinProbability < 0.78 || result.WinProbability > 0.85 {
+		t.Errorf("Expected aces to win about 82%% against kings, got %.3f", result.WinProbability)
+	}
+
+	// Only the two remaining aces fit the range, so the hand is nearly always split
+	aces, _ := ParseRange("AA")
+	result, err = CalculateWinProbability(hole, nil, 2, 2000, WithOpponentRanges(aces))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.TieProbability < 0.9 {
+		t.Errorf("Expected mostly ties against the last two aces, got %.3f", result.TieProbability)
+	}
+
+	// A nil range is a random hand; two ranged opponents cannot share a card
+	result, err = CalculateWinProbability(hole, nil, 4, 2000, WithOpponentRanges(kings, nil, kings))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.Simulations != 2000 {
+		t.Errorf("Expected 2000 simulations, got %d", result.Simulations)
+	}
+}
+
+func TestCalculateWinProbability_OpponentRangesValidation(t *testing.T) {
+	hole, _ := ParseCards([]string{"HA", "SA"})
+	kings, _ := ParseRange("KK")
+	aces, _ := ParseRange("AA")
+
+	if _, err := CalculateWinProbability(hole, nil, 3, 100, WithOpponentRanges(kings)); err == nil {
+		t.Error("Expected error for too few ranges")
+	}
+	if _, err := CalculateWinProbability(hole, nil, 3, 100, WithOpponentRanges(aces, aces)); err == nil {
+		t.Error("Expected error when the ranges cannot both be dealt")
+	}
+	board, _ := ParseCards([]string{"DA", "CA", "S2"})
+	if _, err := CalculateWinProbability(hole, board, 2, 100, WithOpponentRanges(aces)); err == nil {
+		t.Error("Expected error for a range with every combo dead")
+	}
+	omaha, _ := ParseCards([]string{"HA", "SA", "HK", "SK"})
+	if _, err := CalculateWinProbability(omaha, nil, 2, 100, WithGame(Omaha), WithOpponentRanges(kings)); err == nil {
+		t.Error("Expected error for ranges in Omaha")
+	}
+}
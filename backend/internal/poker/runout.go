@@ -0,0 +1,104 @@
+package poker
+
+import (
+	"fmt"
+	"sort"
+)
+
+// runoutSwing is the change in equity, in either direction, that makes a
+// next card good or bad for the hero rather than neutral
+const runoutSwing = 0.1
+
+// RunoutOutcome says how one next community card treats the hero
+type RunoutOutcome string
+
+const (
+	GoodCard    RunoutOutcome = "good"    // raises the hero's equity by at least 10 points
+	NeutralCard RunoutOutcome = "neutral" // moves it by less than 10 points
+	BadCard     RunoutOutcome = "bad"     // lowers it by at least 10 points
+)
+
+// RunoutCard is the hero's position after one possible next community card
+type RunoutCard struct {
+	Card    Card
+	Equity  float64       // the hero's exact equity with the card on the board
+	Swing   float64       // Equity less the equity before the card
+	Rank    HandRank      // the hero's hand category with the card on the board
+	Outcome RunoutOutcome // the swing classified
+}
+
+// RunoutAnalysis lists every possible next community card by what it does to
+// the hero's equity. Each group is sorted best card first.
+type RunoutAnalysis struct {
+	Street  string  // the street the next card makes: "turn" or "river"
+	Equity  float64 // the hero's exact equity before the next card
+	Good    []RunoutCard
+	Neutral []RunoutCard
+	Bad     []RunoutCard
+}
+
+// Runouts analyzes every possible next Hold'em card for the hero
+// (holes[0]) against known opponent hands on a flop or turn
+func Runouts(board []Card, holes ...[]Card) (*RunoutAnalysis, error) {
+	return Holdem.Runouts(board, holes...)
+}
+
+// Runouts analyzes every possible next card for the hero (holes[0]) against
+// known opponent hands on a flop or turn under the rules of the game.
+// Equities are exact: the rest of the board is enumerated after each card.
+func (g Game) Runouts(board []Card, holes ...[]Card) (*RunoutAnalysis, error) {
+	if len(board) != 3 && len(board) != 4 {
+		return nil, fmt.Errorf("runouts need a flop or a turn, not %d community cards", len(board))
+	}
+
+	before, err := CalculateEquity(holes, board, 0, WithGame(g), WithMode(ExactMode))
+	if err != nil {
+		return nil, err
+	}
+	analysis := &RunoutAnalysis{Street: "turn", Equity: before.Players[0].Equity}
+	if len(board) == 4 {
+		analysis.Street = "river"
+	}
+
+	known := NewCardSet(board...)
+	for _, hole := range holes {
+		known |= NewCardSet(hole...)
+	}
+	next := append(append([]Card{}, board...), Card{})
+	for _, id := range g.Deck().Remove(known).IDs() {
+		next[len(board)] = id.Card()
+		after, err := CalculateEquity(holes, next, 0, WithGame(g), WithMode(ExactMode))
+		if err != nil {
+			return nil, err
+		}
+		hand, err := g.Evaluate(holes[0], next)
+		if err != nil {
+			return nil, err
+		}
+
+		card := RunoutCard{
+			Card:   next[len(board)],
+			Equity: after.Players[0].Equity,
+			Swing:  after.Players[0].Equity - analysis.Equity,
+			Rank:   hand.Rank,
+		}
+		switch {
+		case card.Swing >= runoutSwing:
+			card.Outcome = GoodCard
+			analysis.Good = append(analysis.Good, card)
+		case card.Swing <= -runoutSwing:
+			card.Outcome = BadCard
+			analysis.Bad = append(analysis.Bad, card)
+		default:
+			card.Outcome = NeutralCard
+			analysis.Neutral = append(analysis.Neutral, card)
+		}
+	}
+
+	for _, group := range [][]RunoutCard{analysis.Good, analysis.Neutral, analysis.Bad} {
+		sort.SliceStable(group, func(i, j int) bool {
+			return group[i].Equity > group[j].Equity
+		})
+	}
+	return analysis, nil
+}
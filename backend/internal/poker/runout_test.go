@@ -0,0 +1,79 @@
+package poker
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRunouts_River(t *testing.T) {
+	aces, _ := ParseCards([]string{"SA", "HA"})
+	kings, _ := ParseCards([]string{"SK", "HK"})
+	board, _ := ParseCards([]string{"C2", "D7", "S9", "H3"})
+
+	analysis, err := Runouts(board, aces, kings)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if analysis.Street != "river" || math.Abs(analysis.Equity-42.0/44) > 1e-9 {
+		t.Errorf("Expected river equity 42/44, got %s %f", analysis.Street, analysis.Equity)
+	}
+	if len(analysis.Good) != 0 || len(analysis.Neutral) != 42 || len(analysis.Bad) != 2 {
+		t.Fatalf("Expected 0 good, 42 neutral and 2 bad cards, got %d, %d and %d",
+			len(analysis.Good), len(analysis.Neutral), len(analysis.Bad))
+	}
+	for _, card := range analysis.Bad {
+		if card.Card.Rank != 13 || card.Equity != 0 || card.Outcome != BadCard {
+			t.Errorf("Expected only the kings to be bad, got %+v", card)
+		}
+	}
+	for _, card := range analysis.Neutral {
+		if card.Equity != 1 || math.Abs(card.Swing-2.0/44) > 1e-9 {
+			t.Errorf("Expected every other card to lock up the pot, got %+v", card)
+		}
+		if card.Card.Rank == 14 && card.Rank != ThreeOfAKind {
+			t.Errorf("Expected an ace to make a set, got %+v", card)
+		}
+	}
+}
+
+func TestRunouts_Flop(t *testing.T) {
+	// A flush draw against an overpair: hearts are good, most blanks are bad
+	draw, _ := ParseCards([]string{"HA", "HK"})
+	pair, _ := ParseCards([]string{"SQ", "CQ"})
+	board, _ := ParseCards([]string{"H2", "H7", "C9"})
+
+	analysis, err := Runouts(board, draw, pair)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if analysis.Street != "turn" {
+		t.Errorf("Expected the turn, got %s", analysis.Street)
+	}
+
+	total, mean := 0, 0.0
+	for _, group := range [][]RunoutCard{analysis.Good, analysis.Neutral, analysis.Bad} {
+		for i, card := range group {
+			if i > 0 && card.Equity > group[i-1].Equity {
+				t.Errorf("Expected each group sorted best first, got %+v", group)
+			}
+			total++
+			mean += card.Equity
+		}
+	}
+	if total != 45 {
+		t.Errorf("Expected 45 turn cards, got %d", total)
+	}
+	// The equity before the turn is the average over every turn card
+	if math.Abs(mean/45-analysis.Equity) > 1e-9 {
+		t.Errorf("Expected the mean turn equity %f to match %f", mean/45, analysis.Equity)
+	}
+	for _, card := range analysis.Good {
+		if card.Card.Suit == "H" && card.Rank != Flush {
+			t.Errorf("Expected a heart to make the flush, got %+v", card)
+		}
+	}
+
+	if _, err := Runouts(board[:2], draw, pair); err == nil {
+		t.Error("Expected an error without a flop")
+	}
+}
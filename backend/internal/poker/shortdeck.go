@@ -0,0 +1,89 @@
+package poker
+
+import (
+	"fmt"
+)
+
+// shortDeckLowest is the lowest rank in the 36-card short deck
+const shortDeckLowest = 6
+
+// shortDeckOrder ranks short deck hands under the common rules: a flush beats
+// a full house and three of a kind beats a straight
+var shortDeckOrder = categoryOrder{
+	HighCard:      0,
+	OnePair:       1,
+	TwoPair:       2,
+	Straight:      3,
+	ThreeOfAKind:  4,
+	FullHouse:     5,
+	Flush:         6,
+	FourOfAKind:   7,
+	StraightFlush: 8,
+	RoyalFlush:    9,
+}
+
+// shortDeckClassicOrder ranks short deck hands under the original rules,
+// where only the flush moves above the full house
+var shortDeckClassicOrder = categoryOrder{
+	HighCard:      0,
+	OnePair:       1,
+	TwoPair:       2,
+	ThreeOfAKind:  3,
+	Straight:      4,
+	FullHouse:     5,
+	Flush:         6,
+	FourOfAKind:   7,
+	StraightFlush: 8,
+	RoyalFlush:    9,
+}
+
+var (
+	shortDeckTables        = newRankTables(shortDeckLowest, shortDeckEvaluator(&shortDeckOrder), (*Hand).Compare)
+	shortDeckClassicTables = newRankTables(shortDeckLowest, shortDeckEvaluator(&shortDeckClassicOrder), (*Hand).Compare)
+)
+
+// ShortDeckCards contains the 36 cards from six to ace
+var ShortDeckCards = FullDeck.Remove(lowRanks(shortDeckLowest))
+
+// shortDeckEvaluator returns a 5-card evaluator for the short deck, where
+// A-6-7-8-9 is the lowest straight, ranking categories by order
+func shortDeckEvaluator(order *categoryOrder) func([]Card) *Hand {
+	return func(cards []Card) *Hand {
+		hand := evaluateFive(cards, shortDeckLowest)
+		hand.order = order
+		return hand
+	}
+}
+
+// EvaluateShortDeck evaluates the best 5-card short deck hand. With
+// straightBeatsTrips set it uses the original ordering, where a straight
+// still beats three of a kind.
+func EvaluateShortDeck(cards []Card, straightBeatsTrips bool) (*Hand, error) {
+	if len(cards) < 5 {
+		return nil, fmt.Errorf("need at least 5 cards to evaluate a hand")
+	}
+	if HasDuplicates(cards) {
+		return nil, fmt.Errorf("duplicate cards in hand")
+	}
+	for _, card := range cards {
+		if card.Rank < shortDeckLowest {
+			return nil, fmt.Errorf("card %s is not in the short deck", card)
+		}
+	}
+
+	if straightBeatsTrips {
+		return shortDeckClassicTables.best(cards), nil
+	}
+	return shortDeckTables.best(cards), nil
+}
+
+// lowRanks returns every card ranked below lowest
+func lowRanks(lowest int) CardSet {
+	var cs CardSet
+	for rank := 2; rank < lowest; rank++ {
+		for s := 0; s < 4; s++ {
+			cs = cs.Add(CardID(s*13 + rank - 2))
+		}
+	}
+	return cs
+}
@@ -0,0 +1,128 @@
+package poker
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestEvaluateShortDeck_Ordering(t *testing.T) {
+	tests := []struct {
+		name               string
+		hand1              []string
+		hand2              []string
+		straightBeatsTrips bool
+		expected           int
+	}{
+		{
+			name:     "Flush beats full house",
+			hand1:    []string{"S6", "S8", "ST", "SQ", "SA"},
+			hand2:    []string{"HK", "DK", "CK", "H9", "D9"},
+			expected: 1,
+		},
+		{
+			name:     "Trips beat straight",
+			hand1:    []string{"H7", "D7", "C7", "HA", "DK"},
+			hand2:    []string{"HT", "DJ", "CQ", "SK", "HA"},
+			expected: 1,
+		},
+		{
+			name:               "Straight beats trips under classic rules",
+			hand1:              []string{"H7", "D7", "C7", "HA", "DK"},
+			hand2:              []string{"HT", "DJ", "CQ", "SK", "HA"},
+			straightBeatsTrips: true,
+			expected:           -1,
+		},
+		{
+			name:     "A-6-7-8-9 is the lowest straight",
+			hand1:    []string{"HA", "D6", "C7", "S8", "H9"},
+			hand2:    []string{"H6", "D7", "C8", "S9", "HT"},
+			expected: -1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cards1, _ := ParseCards(tt.hand1)
+			cards2, _ := ParseCards(tt.hand2)
+			hand1, err := EvaluateShortDeck(cards1, tt.straightBeatsTrips)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			hand2, err := EvaluateShortDeck(cards2, tt.straightBeatsTrips)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result := hand1.Compare(hand2); result != tt.expected {
+				t.Errorf("Expected %d, got %d. Hand1: %s, Hand2: %s", tt.expected, result, hand1.Description, hand2.Description)
+			}
+			if (hand1.Strength() > hand2.Strength()) != (tt.expected > 0) {
+				t.Errorf("Strengths %d and %d disagree with Compare", hand1.Strength(), hand2.Strength())
+			}
+		})
+	}
+}
+
+func TestEvaluateShortDeck_Wheel(t *testing.T) {
+	cards, _ := ParseCards([]string{"HA", "D6", "C7", "S8", "H9", "DK", "CQ"})
+	hand, err := ShortDeck.Evaluate(cards[:2], cards[2:])
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if hand.Rank != Straight || hand.RankDetail[0] != 9 {
+		t.Errorf("Expected a nine-high straight, got %s", hand.Description)
+	}
+}
+
+func TestEvaluateShortDeck_RejectsLowCards(t *testing.T) {
+	cards, _ := ParseCards([]string{"HA", "D5", "C7", "S8", "H9"})
+	if _, err := EvaluateShortDeck(cards, false); err == nil {
+		t.Error("Expected error for a five in the short deck")
+	}
+	if ShortDeckCards.Count() != 36 {
+		t.Errorf("Expected 36 cards, got %d", ShortDeckCards.Count())
+	}
+}
+
+func TestShortDeckTables_MatchBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(4))
+	evaluate := shortDeckEvaluator(&shortDeckOrder)
+	ids := ShortDeckCards.IDs()
+	for i := 0; i < 3000; i++ {
+		rng.Shuffle(len(ids), func(a, b int) { ids[a], ids[b] = ids[b], ids[a] })
+		cards := make([]Card, 7)
+		for j := range cards {
+			cards[j] = ids[j].Card()
+		}
+
+		var want *Hand
+		forEachCombination(7, 5, func(idx []int) bool {
+			combo := make([]Card, 5)
+			for k, j := range idx {
+				combo[k] = cards[j]
+			}
+			if hand := evaluate(combo); want == nil || hand.Compare(want) > 0 {
+				want = hand
+			}
+			return true
+		})
+
+		got, err := EvaluateShortDeck(cards, false)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got.Compare(want) != 0 || got.Description != want.Description {
+			t.Fatalf("Mismatch for %v: got %s, want %s", cards, got.Description, want.Description)
+		}
+	}
+}
+
+func TestCalculateWinProbability_ShortDeck(t *testing.T) {
+	hole, _ := ParseCards([]string{"HA", "SA"})
+	if _, err := CalculateWinProbability(hole, nil, 6, 1000, WithGame(ShortDeck)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	low, _ := ParseCards([]string{"H2", "SA"})
+	if _, err := CalculateWinProbability(low, nil, 6, 1000, WithGame(ShortDeck)); err == nil {
+		t.Error("Expected error for a deuce in the short deck")
+	}
+}
@@ -0,0 +1,99 @@
+package poker
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ShowdownResult ranks every player's hand at showdown. Player indices refer
+// to the order the hole cards were given in.
+type ShowdownResult struct {
+	Hands   []*Hand   // each player's best hand
+	Ranking [][]int   // players grouped into ties, best group first
+	Winners []int     // players who win or split the pot (the high half in hi/lo games)
+	Shares  []float64 // fraction of the pot each player wins
+
+	// Hi/lo games only
+	Lows       []*Hand // each player's qualifying low, or nil
+	LowWinners []int   // players who split the low half
+}
+
+// Showdown evaluates a Hold'em showdown between 2 to 10 players sharing one
+// board and ranks their hands
+func Showdown(board []Card, holes ...[]Card) (*ShowdownResult, error) {
+	return Holdem.Showdown(board, holes...)
+}
+
+// Showdown evaluates a showdown between 2 to 10 players under the rules of
+// the game and ranks their hands
+func (g Game) Showdown(board []Card, holes ...[]Card) (*ShowdownResult, error) {
+	if len(holes) < 2 || len(holes) > 10 {
+		return nil, fmt.Errorf("number of players must be between 2 and 10")
+	}
+	allCards := append([]Card{}, board...)
+	for i, hole := range holes {
+		if len(hole) != g.HoleCards() {
+			return nil, fmt.Errorf("player %d must have exactly %d hole cards", i+1, g.HoleCards())
+		}
+		allCards = append(allCards, hole...)
+	}
+	if HasDuplicates(allCards) {
+		return nil, fmt.Errorf("duplicate cards detected")
+	}
+
+	result := &ShowdownResult{Hands: make([]*Hand, len(holes))}
+	for i, hole := range holes {
+		hand, err := g.Evaluate(hole, board)
+		if err != nil {
+			return nil, fmt.Errorf("player %d: %v", i+1, err)
+		}
+		result.Hands[i] = hand
+	}
+	result.Ranking = rankHands(result.Hands)
+
+	if !g.IsHiLo() {
+		result.Winners = result.Ranking[0]
+		result.Shares = make([]float64, len(holes))
+		for _, i := range result.Winners {
+			result.Shares[i] = 1 / float64(len(result.Winners))
+		}
+		return result, nil
+	}
+
+	hiLoHands := make([]*HiLoHand, len(holes))
+	result.Lows = make([]*Hand, len(holes))
+	for i, hole := range holes {
+		hand, err := g.EvaluateHiLo(hole, board)
+		if err != nil {
+			return nil, fmt.Errorf("player %d: %v", i+1, err)
+		}
+		hiLoHands[i] = hand
+		result.Lows[i] = hand.Low
+	}
+	pot := SplitHiLoPot(hiLoHands)
+	result.Winners = pot.HighWinners
+	result.LowWinners = pot.LowWinners
+	result.Shares = pot.Shares
+	return result, nil
+}
+
+// rankHands orders player indices from best hand to worst, grouping ties
+func rankHands(hands []*Hand) [][]int {
+	order := make([]int, len(hands))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return hands[order[a]].Compare(hands[order[b]]) > 0
+	})
+
+	var ranking [][]int
+	for i, player := range order {
+		if i > 0 && hands[player].Compare(hands[order[i-1]]) == 0 {
+			ranking[len(ranking)-1] = append(ranking[len(ranking)-1], player)
+			continue
+		}
+		ranking = append(ranking, []int{player})
+	}
+	return ranking
+}
@@ -0,0 +1,91 @@
+package poker
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestShowdown(t *testing.T) {
+	board, _ := ParseCards([]string{"DA", "S9", "H7", "C5", "D3"})
+	p1, _ := ParseCards([]string{"SA", "HQ"}) // aces, queen kicker
+	p2, _ := ParseCards([]string{"HA", "CQ"}) // aces, queen kicker
+	p3, _ := ParseCards([]string{"S7", "D7"}) // set of sevens
+	p4, _ := ParseCards([]string{"SK", "HJ"}) // ace high
+	p5, _ := ParseCards([]string{"CA", "DJ"}) // aces, jack kicker
+
+	result, err := Showdown(board, p1, p2, p3, p4, p5)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := [][]int{{2}, {0, 1}, {4}, {3}}
+	if !reflect.DeepEqual(result.Ranking, expected) {
+		t.Errorf("Expected ranking %v, got %v", expected, result.Ranking)
+	}
+	if !reflect.DeepEqual(result.Winners, []int{2}) {
+		t.Errorf("Expected player 2 to win, got %v", result.Winners)
+	}
+	if result.Shares[2] != 1 {
+		t.Errorf("Expected the set to win the whole pot, got %v", result.Shares)
+	}
+}
+
+func TestShowdown_SplitPot(t *testing.T) {
+	board, _ := ParseCards([]string{"SA", "HK", "DQ", "CJ", "ST"})
+	p1, _ := ParseCards([]string{"S2", "H3"})
+	p2, _ := ParseCards([]string{"D4", "C5"})
+	p3, _ := ParseCards([]string{"D6", "C6"})
+
+	result, err := Showdown(board, p1, p2, p3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(result.Winners, []int{0, 1, 2}) || len(result.Ranking) != 1 {
+		t.Errorf("Expected a three-way split, got %v", result.Ranking)
+	}
+	for i, share := range result.Shares {
+		if share != 1.0/3 {
+			t.Errorf("Expected player %d to get a third, got %f", i, share)
+		}
+	}
+}
+
+func TestShowdown_Validation(t *testing.T) {
+	board, _ := ParseCards([]string{"SA", "HK", "DQ", "CJ", "ST"})
+	p1, _ := ParseCards([]string{"S2", "H3"})
+	dup, _ := ParseCards([]string{"S2", "H4"})
+
+	if _, err := Showdown(board, p1); err == nil {
+		t.Error("Expected error for a single player")
+	}
+	if _, err := Showdown(board, p1, dup); err == nil {
+		t.Error("Expected error for duplicate cards")
+	}
+
+	short, _ := ParseCards([]string{"S9"})
+	long, _ := ParseCards([]string{"H9", "D9", "C9"})
+	if _, err := Showdown(board, short, long); err == nil {
+		t.Error("Expected error for the wrong number of hole cards")
+	}
+	p2, _ := ParseCards([]string{"H4", "D4"})
+	if _, err := Omaha.Showdown(board, p1, p2); err == nil {
+		t.Error("Expected error for two hole cards in Omaha")
+	}
+}
+
+func TestShowdown_HiLo(t *testing.T) {
+	board, _ := ParseCards([]string{"S3", "H4", "D8", "CK", "SK"})
+	p1, _ := ParseCards([]string{"HA", "H2", "DK", "C9"})
+	p2, _ := ParseCards([]string{"CT", "DT", "HQ", "HJ"})
+
+	result, err := Omaha8.Showdown(board, p1, p2)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(result.Winners, []int{0}) || !reflect.DeepEqual(result.LowWinners, []int{0}) {
+		t.Errorf("Expected player 0 to scoop, got high %v low %v", result.Winners, result.LowWinners)
+	}
+	if result.Lows[1] != nil {
+		t.Errorf("Expected player 1 to have no low, got %s", result.Lows[1].Description)
+	}
+}
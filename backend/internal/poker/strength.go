@@ -0,0 +1,64 @@
+package poker
+
+import (
+	"fmt"
+)
+
+// HandStrength is the equivalence class of a 5-card hand, from 1 (seven-high,
+// the weakest) to MaxHandStrength (a royal flush). Hands that tie under
+// Hand.Compare share a strength, so strengths can be stored and compared with <.
+type HandStrength uint16
+
+// MaxHandStrength is the number of distinct 5-card hands
+const MaxHandStrength HandStrength = 7462
+
+// Strength returns the equivalence class of the hand. Short deck hands are
+// numbered within their own ordering, and low hands have no strength (0).
+func (h *Hand) Strength() HandStrength {
+	return h.strength
+}
+
+// EvaluateCardSet returns the strength of the best hand in a set of 5 to 7
+// cards without allocating
+func EvaluateCardSet(cs CardSet) (HandStrength, error) {
+	if n := cs.Count(); n < 5 || n > maxQuinaryCards {
+		return 0, fmt.Errorf("need 5 to %d cards to evaluate a card set, got %d", maxQuinaryCards, n)
+	}
+	return HandStrength(standardTables.evaluate(cs)), nil
+}
+
+// Rank returns the hand category of the strength
+func (s HandStrength) Rank() HandRank {
+	if s < 1 || s > MaxHandStrength {
+		return -1
+	}
+	return standardTables.hands[s].Rank
+}
+
+// DescribeStrength returns a canonical hand for a strength: its rank,
+// tie-breaking detail and description, with example cards that make it
+func DescribeStrength(s HandStrength) (*Hand, error) {
+	return Holdem.DescribeStrength(s)
+}
+
+// MaxStrength returns the number of distinct 5-card hands in the game.
+// Lowball hands have no strength classes, so lowball games return 0.
+func (g Game) MaxStrength() HandStrength {
+	if g.IsLowball() {
+		return 0
+	}
+	return HandStrength(len(g.tables().hands) - 1)
+}
+
+// DescribeStrength returns a canonical hand for a strength in the game's
+// own ordering
+func (g Game) DescribeStrength(s HandStrength) (*Hand, error) {
+	if g.IsLowball() {
+		return nil, fmt.Errorf("%s hands have no strength classes", g)
+	}
+	t := g.tables()
+	if s < 1 || s > g.MaxStrength() {
+		return nil, fmt.Errorf("%s hand strength must be between 1 and %d", g, g.MaxStrength())
+	}
+	return t.hand(uint16(s), t.hands[s].Cards), nil
+}
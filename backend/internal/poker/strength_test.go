@@ -0,0 +1,94 @@
+package poker
+
+import (
+	"testing"
+)
+
+func TestHandStrength_Bounds(t *testing.T) {
+	tests := []struct {
+		cards    []string
+		expected HandStrength
+	}{
+		{[]string{"H7", "D5", "C4", "S3", "H2"}, 1},
+		{[]string{"HA", "HK", "HQ", "HJ", "HT"}, MaxHandStrength},
+		{[]string{"SA", "SK", "SQ", "SJ", "ST", "H2", "D3"}, MaxHandStrength},
+	}
+
+	for _, tt := range tests {
+		cards, _ := ParseCards(tt.cards)
+		hand, err := EvaluateHand(cards)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if hand.Strength() != tt.expected {
+			t.Errorf("Expected strength %d for %v, got %d", tt.expected, tt.cards, hand.Strength())
+		}
+		strength, err := EvaluateCardSet(NewCardSet(cards...))
+		if err != nil || strength != tt.expected {
+			t.Errorf("EvaluateCardSet(%v) = %d, %v; expected %d", tt.cards, strength, err, tt.expected)
+		}
+	}
+}
+
+func TestHandStrength_OrdersLikeCompare(t *testing.T) {
+	hands := [][]string{
+		{"SA", "HK", "DQ", "CJ", "S9"},
+		{"SA", "HA", "DK", "CQ", "SJ"},
+		{"SA", "HA", "DK", "CK", "SQ"},
+		{"SA", "H2", "D3", "C4", "S5"},
+		{"SA", "SK", "SQ", "S9", "S7"},
+		{"SA", "HA", "DA", "CK", "SK"},
+	}
+	var prev *Hand
+	for _, h := range hands {
+		cards, _ := ParseCards(h)
+		hand, _ := EvaluateHand(cards)
+		if prev != nil && !(prev.Strength() < hand.Strength()) {
+			t.Errorf("Expected %s (%d) < %s (%d)", prev.Description, prev.Strength(), hand.Description, hand.Strength())
+		}
+		prev = hand
+	}
+}
+
+func TestDescribeStrength(t *testing.T) {
+	for s := HandStrength(1); s <= MaxHandStrength; s++ {
+		hand, err := DescribeStrength(s)
+		if err != nil {
+			t.Fatalf("Unexpected error for %d: %v", s, err)
+		}
+		again, err := EvaluateHand(hand.Cards)
+		if err != nil {
+			t.Fatalf("Unexpected error for %d: %v", s, err)
+		}
+		if again.Strength() != s || hand.Strength() != s {
+			t.Fatalf("Example cards for %d (%s) evaluate to %d", s, hand.Description, again.Strength())
+		}
+		if s.Rank() != hand.Rank {
+			t.Fatalf("Rank mismatch for %d: %s vs %s", s, s.Rank(), hand.Rank)
+		}
+	}
+	if _, err := DescribeStrength(0); err == nil {
+		t.Error("Expected error for strength 0")
+	}
+	if _, err := DescribeStrength(MaxHandStrength + 1); err == nil {
+		t.Error("Expected error for strength above the maximum")
+	}
+}
+
+func TestMaxStrength(t *testing.T) {
+	tests := []struct {
+		game     Game
+		expected HandStrength
+	}{
+		{Holdem, MaxHandStrength},
+		{Omaha, MaxHandStrength},
+		{Razz, 0},
+		{DeuceToSeven, 0},
+	}
+
+	for _, tt := range tests {
+		if got := tt.game.MaxStrength(); got != tt.expected {
+			t.Errorf("%s: Expected max strength %d, got %d", tt.game, tt.expected, got)
+		}
+	}
+}
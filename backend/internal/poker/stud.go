@@ -0,0 +1,132 @@
+package poker
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// StudHand holds one seven card stud player's cards. Down cards are only
+// known for the hero; up cards are exposed to the table.
+type StudHand struct {
+	DownCards []Card // up to three hole cards
+	UpCards   []Card // up to four exposed cards in the order dealt; the first is the door card
+}
+
+// Cards returns all of the player's known cards
+func (h StudHand) Cards() []Card {
+	return append(append([]Card{}, h.DownCards...), h.UpCards...)
+}
+
+// Validate checks the card counts of a stud hand
+func (h StudHand) Validate() error {
+	if len(h.DownCards) > 3 {
+		return fmt.Errorf("a stud hand has at most 3 down cards")
+	}
+	if len(h.UpCards) > 4 {
+		return fmt.Errorf("a stud hand has at most 4 up cards")
+	}
+	return nil
+}
+
+// BringIn returns the index of the player who must bring in on third
+// street. In Stud and Stud hi/lo the lowest door card brings in, with aces
+// high; in Razz the highest door card does, with aces low. Equal ranks are
+// broken by suit, from clubs (lowest) through diamonds and hearts to spades.
+func (g Game) BringIn(doorCards []Card) (int, error) {
+	if !g.IsStud() {
+		return 0, fmt.Errorf("%s has no bring-in", g)
+	}
+	if len(doorCards) < 2 {
+		return 0, fmt.Errorf("need at least 2 door cards")
+	}
+	if HasDuplicates(doorCards) {
+		return 0, fmt.Errorf("duplicate door cards")
+	}
+
+	// Order the door cards from lowest to highest in the game's terms
+	value := func(card Card) int {
+		rank := card.Rank
+		if g == Razz {
+			rank = lowValue(rank)
+		}
+		return rank*4 + strings.Index(suitOrder, card.Suit)
+	}
+
+	bringIn := 0
+	for i := 1; i < len(doorCards); i++ {
+		v, current := value(doorCards[i]), value(doorCards[bringIn])
+		if (g == Razz && v > current) || (g != Razz && v < current) {
+			bringIn = i
+		}
+	}
+	return bringIn, nil
+}
+
+// CalculateStudEquity calculates each player's equity in a seven card stud
+// game using Monte Carlo simulation. Every player's up cards, any known down
+// cards and the dead cards (up cards of folded players) are removed from the
+// deck; the rest of each hand is dealt at random.
+func CalculateStudEquity(game Game, players []StudHand, deadCards []Card, numSimulations int) (*EquityResult, error) {
+	if !game.IsStud() {
+		return nil, fmt.Errorf("%s is not a stud game", game)
+	}
+	if len(players) < 2 || len(players) > 8 {
+		return nil, fmt.Errorf("number of players must be between 2 and 8")
+	}
+	if numSimulations < 1 {
+		return nil, fmt.Errorf("number of simulations must be at least 1")
+	}
+
+	known := append([]Card{}, deadCards...)
+	hands := make([]CardSet, len(players))
+	missing := make([]int, len(players))
+	needed := 0
+	for i, player := range players {
+		if err := player.Validate(); err != nil {
+			return nil, fmt.Errorf("player %d: %v", i+1, err)
+		}
+		cards := player.Cards()
+		known = append(known, cards...)
+		hands[i] = NewCardSet(cards...)
+		missing[i] = 7 - len(cards)
+		needed += missing[i]
+	}
+	if HasDuplicates(known) {
+		return nil, fmt.Errorf("duplicate cards detected")
+	}
+	deck := FullDeck.Remove(NewCardSet(known...)).IDs()
+	if needed > len(deck) {
+		return nil, fmt.Errorf("not enough cards left to deal every player seven cards")
+	}
+
+	tables := game.tables()
+	highs := make([]uint16, len(players))
+	var lows []uint16
+	if game.IsHiLo() {
+		lows = make([]uint16, len(players))
+	}
+	shares := make([]float64, len(players))
+	tally := newEquityTally(len(players))
+
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	for sim := 0; sim < numSimulations; sim++ {
+		dealDeck(deck, needed, rng)
+		deckIdx := 0
+		for i, hand := range hands {
+			for k := 0; k < missing[i]; k++ {
+				hand = hand.Add(deck[deckIdx])
+				deckIdx++
+			}
+			highs[i] = tables.evaluate(hand)
+			if lows != nil {
+				lows[i] = lowEightScore(lowestFive(lowEightMask(hand)))
+			}
+		}
+		potShares(shares, highs, lows)
+		tally.add(shares)
+	}
+
+	return tally.result(), nil
+}
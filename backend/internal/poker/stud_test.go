@@ -0,0 +1,102 @@
+package poker
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestBringIn(t *testing.T) {
+	tests := []struct {
+		name     string
+		game     Game
+		doors    []string
+		expected int
+	}{
+		{"Lowest card brings in", Stud, []string{"HK", "D2", "S9"}, 1},
+		{"Ace is high in stud", Stud, []string{"HA", "D3", "S9"}, 1},
+		{"Clubs is the lowest suit", Stud, []string{"H2", "C2", "S9"}, 1},
+		{"Stud hi/lo uses stud rules", Stud8, []string{"S4", "D4", "HA"}, 1},
+		{"Highest card brings in for razz", Razz, []string{"HK", "D2", "S9"}, 0},
+		{"Ace is low in razz", Razz, []string{"HA", "D3", "S2"}, 1},
+		{"Spades is the highest suit", Razz, []string{"HK", "SK", "D2"}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doors, _ := ParseCards(tt.doors)
+			got, err := tt.game.BringIn(doors)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Expected player %d to bring in, got %d", tt.expected, got)
+			}
+		})
+	}
+
+	doors, _ := ParseCards([]string{"HK", "D2"})
+	if _, err := Holdem.BringIn(doors); err == nil {
+		t.Error("Expected error for a game without a bring-in")
+	}
+}
+
+func TestCalculateStudEquity(t *testing.T) {
+	down, _ := ParseCards([]string{"HA", "SA"})
+	up1, _ := ParseCards([]string{"DA"})
+	up2, _ := ParseCards([]string{"C7"})
+	up3, _ := ParseCards([]string{"D9"})
+	dead, _ := ParseCards([]string{"CA", "S7"})
+	players := []StudHand{
+		{DownCards: down, UpCards: up1},
+		{UpCards: up2},
+		{UpCards: up3},
+	}
+
+	for _, game := range []Game{Stud, Stud8, Razz} {
+		result, err := CalculateStudEquity(game, players, dead, 2000)
+		if err != nil {
+			t.Fatalf("%s: Unexpected error: %v", game, err)
+		}
+		total := 0.0
+		for _, p := range result.Players {
+			total += p.Equity
+		}
+		if math.Abs(total-1) > 1e-9 {
+			t.Errorf("%s: equities sum to %f", game, total)
+		}
+		if game == Stud && result.Players[0].Equity < 0.7 {
+			t.Errorf("Expected rolled-up aces to be a big favourite, got %f", result.Players[0].Equity)
+		}
+	}
+
+	if _, err := CalculateStudEquity(Stud, players, append(dead, down[0]), 100); err == nil {
+		t.Error("Expected error for a dead card that is also in a hand")
+	}
+	if _, err := CalculateStudEquity(Holdem, players, nil, 100); err == nil {
+		t.Error("Expected error for a non-stud game")
+	}
+}
+
+func TestRazzTables_MatchEvaluateAceToFive(t *testing.T) {
+	rng := rand.New(rand.NewSource(5))
+	for i := 0; i < 2000; i++ {
+		cards := randomCards(rng, 14)
+		a, b := cards[:7], cards[7:]
+		handA, _ := EvaluateAceToFive(a)
+		handB, _ := EvaluateAceToFive(b)
+		want := handA.Compare(handB)
+
+		sa := razzTables.evaluate(NewCardSet(a...))
+		sb := razzTables.evaluate(NewCardSet(b...))
+		got := 0
+		if sa > sb {
+			got = 1
+		} else if sa < sb {
+			got = -1
+		}
+		if got != want {
+			t.Fatalf("Razz table order %d disagrees with Compare %d: %s vs %s", got, want, handA.Description, handB.Description)
+		}
+	}
+}
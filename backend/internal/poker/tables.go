@@ -0,0 +1,295 @@
+package poker
+
+import (
+	"math/bits"
+	"sort"
+)
+
+// The table-driven evaluator scores a set of 5 to 7 cards without allocating.
+//
+// Every distinct 5-card hand belongs to an equivalence class, numbered from 1
+// (the weakest) upwards so that a higher class always wins under Hand.Compare.
+// For low hands "wins" means lower, so the best low has the highest class.
+// Two tables map card patterns to classes:
+//
+//   - flush is indexed by the 13-bit rank mask of a single suit and holds the
+//     best flush or straight flush that suit makes.
+//   - noFlush is indexed by a perfect hash of the rank counts (a base-5 number
+//     with 13 digits, one per rank) and holds the best hand that ignores suits.
+//
+// The best hand of a card set is the larger of the two lookups.
+
+// maxQuinaryCards is the largest card count the rank-count tables cover
+const maxQuinaryCards = 7
+
+// quinaryWays[k][m] is the number of ways to place k cards into m ranks with
+// at most four cards per rank
+var quinaryWays = buildQuinaryWays()
+
+// quinaryOffset[i][k][v] is added to the hash when rank index i holds v cards
+// and k cards remain to be placed from rank i onwards
+var quinaryOffset = buildQuinaryOffsets()
+
+func buildQuinaryWays() [maxQuinaryCards + 1][14]int {
+	var ways [maxQuinaryCards + 1][14]int
+	for m := 0; m <= 13; m++ {
+		ways[0][m] = 1
+	}
+	for k := 1; k <= maxQuinaryCards; k++ {
+		for m := 1; m <= 13; m++ {
+			for v := 0; v <= 4 && v <= k; v++ {
+				ways[k][m] += ways[k-v][m-1]
+			}
+		}
+	}
+	return ways
+}
+
+func buildQuinaryOffsets() [13][maxQuinaryCards + 1][5]int {
+	var offsets [13][maxQuinaryCards + 1][5]int
+	for i := 0; i < 13; i++ {
+		for k := 0; k <= maxQuinaryCards; k++ {
+			sum := 0
+			for v := 0; v <= 4; v++ {
+				offsets[i][k][v] = sum
+				if v <= k {
+					sum += quinaryWays[k-v][12-i]
+				}
+			}
+		}
+	}
+	return offsets
+}
+
+// quinaryHash maps rank counts summing to n onto 0..quinaryWays[n][13]-1
+func quinaryHash(counts *[13]uint8, n int) int {
+	hash := 0
+	for i := 0; i < 13; i++ {
+		hash += quinaryOffset[i][n][counts[i]]
+		n -= int(counts[i])
+	}
+	return hash
+}
+
+// rankCounts returns how many cards of each rank index (0 = deuce) are in cs
+func (cs CardSet) rankCounts() [13]uint8 {
+	var counts [13]uint8
+	for s := 0; s < 4; s++ {
+		for m := cs.suitMask(s); m != 0; m &= m - 1 {
+			counts[bits.TrailingZeros16(m)]++
+		}
+	}
+	return counts
+}
+
+// rankTables holds the lookup tables for one ordering of poker hands
+type rankTables struct {
+	flush   [1 << 13]uint16
+	noFlush [maxQuinaryCards + 1][]uint16
+	hands   []*Hand // representative hand for each class; hands[0] is unused
+}
+
+// standardTables ranks hands by the usual high-hand order
+var standardTables = newRankTables(2, evaluateFiveCards, (*Hand).Compare)
+
+// newRankTables enumerates every 5-card class of a deck whose lowest rank is
+// lowest, orders them with compare and fills the lookup tables for 5, 6 and
+// 7 cards
+func newRankTables(lowest int, evaluate func([]Card) *Hand, compare func(h1, h2 *Hand) int) *rankTables {
+	t := &rankTables{}
+	excluded := uint16(1)<<(lowest-2) - 1 // rank bits below the deck
+
+	type class struct {
+		hand   *Hand
+		assign func(uint16)
+	}
+	var classes []class
+
+	// Flushes and straight flushes: every mask with exactly five ranks
+	for mask := 0; mask < 1<<13; mask++ {
+		if bits.OnesCount16(uint16(mask)) != 5 || uint16(mask)&excluded != 0 {
+			continue
+		}
+		cards := make([]Card, 0, 5)
+		for m := uint16(mask); m != 0; m &= m - 1 {
+			cards = append(cards, Card{Rank: bits.TrailingZeros16(m) + 2, Suit: "S"})
+		}
+		mask := mask
+		classes = append(classes, class{evaluate(cards), func(v uint16) { t.flush[mask] = v }})
+	}
+
+	// Everything else: every rank-count pattern of five cards, dealt in
+	// rotating suits so that it never forms a flush
+	t.noFlush[5] = make([]uint16, quinaryWays[5][13])
+	forEachQuinary(5, func(counts *[13]uint8) {
+		for i := 0; i < lowest-2; i++ {
+			if counts[i] != 0 {
+				return
+			}
+		}
+		cards := make([]Card, 0, 5)
+		for i := 12; i >= 0; i-- {
+			for c := uint8(0); c < counts[i]; c++ {
+				cards = append(cards, Card{Rank: i + 2, Suit: string(suitOrder[len(cards)%4])})
+			}
+		}
+		hash := quinaryHash(counts, 5)
+		classes = append(classes, class{evaluate(cards), func(v uint16) { t.noFlush[5][hash] = v }})
+	})
+
+	// Number the classes in ascending order; patterns that compare equal
+	// (a suited and an offsuit low, say) share a class
+	sort.SliceStable(classes, func(i, j int) bool {
+		return compare(classes[i].hand, classes[j].hand) < 0
+	})
+	t.hands = []*Hand{nil}
+	for i, c := range classes {
+		if i == 0 || compare(c.hand, classes[i-1].hand) != 0 {
+			t.hands = append(t.hands, c.hand)
+		}
+		c.assign(uint16(len(t.hands) - 1))
+	}
+
+	// Larger flush masks take the best of their five-card subsets
+	for mask := 0; mask < 1<<13; mask++ {
+		if bits.OnesCount16(uint16(mask)) <= 5 {
+			continue
+		}
+		for m := uint16(mask); m != 0; m &= m - 1 {
+			sub := uint16(mask) &^ (m & -m)
+			if v := t.flush[sub]; v > t.flush[mask] {
+				t.flush[mask] = v
+			}
+		}
+	}
+
+	// Six and seven cards take the best pattern with one card removed
+	for n := 6; n <= maxQuinaryCards; n++ {
+		t.noFlush[n] = make([]uint16, quinaryWays[n][13])
+		forEachQuinary(n, func(counts *[13]uint8) {
+			best := uint16(0)
+			for i := 0; i < 13; i++ {
+				if counts[i] == 0 {
+					continue
+				}
+				counts[i]--
+				if v := t.noFlush[n-1][quinaryHash(counts, n-1)]; v > best {
+					best = v
+				}
+				counts[i]++
+			}
+			t.noFlush[n][quinaryHash(counts, n)] = best
+		})
+	}
+
+	return t
+}
+
+// forEachQuinary calls fn with every rank-count pattern holding n cards
+func forEachQuinary(n int, fn func(counts *[13]uint8)) {
+	var counts [13]uint8
+	var fill func(i, left int)
+	fill = func(i, left int) {
+		if i == 13 {
+			if left == 0 {
+				fn(&counts)
+			}
+			return
+		}
+		for v := 0; v <= 4 && v <= left; v++ {
+			counts[i] = uint8(v)
+			fill(i+1, left-v)
+		}
+		counts[i] = 0
+	}
+	fill(0, n)
+}
+
+// evaluate returns the class of the best hand in a set of 5 to 7 cards
+func (t *rankTables) evaluate(cs CardSet) uint16 {
+	best := uint16(0)
+	for s := 0; s < 4; s++ {
+		if v := t.flush[cs.suitMask(s)]; v > best {
+			best = v
+		}
+	}
+	counts := cs.rankCounts()
+	if v := t.noFlush[cs.Count()][quinaryHash(&counts, cs.Count())]; v > best {
+		best = v
+	}
+	return best
+}
+
+// hand builds a Hand for the given class from the five cards that make it
+func (t *rankTables) hand(class uint16, cards []Card) *Hand {
+	template := t.hands[class]
+	detail := make([]int, len(template.RankDetail))
+	copy(detail, template.RankDetail)
+	return &Hand{
+		Rank:        template.Rank,
+		RankDetail:  detail,
+		Cards:       SortCards(cards),
+		Description: template.Description,
+		strength:    HandStrength(class),
+		low:         template.low,
+		order:       template.order,
+	}
+}
+
+// best finds the best 5-card hand among cards. For up to seven cards the
+// class comes straight from the tables; the five cards reported are the first
+// combination, in input order, that reaches it.
+func (t *rankTables) best(cards []Card) *Hand {
+	var target uint16
+	if len(cards) <= maxQuinaryCards {
+		target = t.evaluate(NewCardSet(cards...))
+	}
+
+	var bestClass uint16
+	var bestIdx [5]int
+	forEachCombination(len(cards), 5, func(idx []int) bool {
+		var cs CardSet
+		for _, i := range idx {
+			cs |= cardBit(cards[i].ID())
+		}
+		if v := t.evaluate(cs); v > bestClass {
+			bestClass = v
+			copy(bestIdx[:], idx)
+		}
+		return target == 0 || bestClass < target
+	})
+
+	combo := make([]Card, 5)
+	for i, idx := range bestIdx {
+		combo[i] = cards[idx]
+	}
+	return t.hand(bestClass, combo)
+}
+
+// forEachCombination calls fn with the indices of every k-combination of n
+// items in lexicographic order until fn returns false
+func forEachCombination(n, k int, fn func(idx []int) bool) {
+	if k > n {
+		return
+	}
+	idx := make([]int, k)
+	for i := range idx {
+		idx[i] = i
+	}
+	for {
+		if !fn(idx) {
+			return
+		}
+		i := k - 1
+		for i >= 0 && idx[i] == n-k+i {
+			i--
+		}
+		if i < 0 {
+			return
+		}
+		idx[i]++
+		for j := i + 1; j < k; j++ {
+			idx[j] = idx[j-1] + 1
+		}
+	}
+}
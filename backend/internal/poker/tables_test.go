@@ -0,0 +1,106 @@
+package poker
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// bruteForceEvaluate is the reference evaluator: the best of every 5-card
+// combination scored with evaluateFiveCards
+func bruteForceEvaluate(cards []Card) *Hand {
+	var best *Hand
+	forEachCombination(len(cards), 5, func(idx []int) bool {
+		combo := make([]Card, 5)
+		for i, j := range idx {
+			combo[i] = cards[j]
+		}
+		hand := evaluateFiveCards(combo)
+		if best == nil || hand.Compare(best) > 0 {
+			best = hand
+		}
+		return true
+	})
+	return best
+}
+
+func randomCards(rng *rand.Rand, n int) []Card {
+	ids := FullDeck.IDs()
+	rng.Shuffle(len(ids), func(i, j int) { ids[i], ids[j] = ids[j], ids[i] })
+	cards := make([]Card, n)
+	for i := range cards {
+		cards[i] = ids[i].Card()
+	}
+	return cards
+}
+
+func TestRankTables_ClassCount(t *testing.T) {
+	if got := len(standardTables.hands) - 1; got != 7462 {
+		t.Errorf("Expected 7462 hand classes, got %d", got)
+	}
+	for class := 2; class < len(standardTables.hands); class++ {
+		if standardTables.hands[class].Compare(standardTables.hands[class-1]) <= 0 {
+			t.Fatalf("Class %d (%s) does not beat class %d (%s)", class,
+				standardTables.hands[class].Description, class-1, standardTables.hands[class-1].Description)
+		}
+	}
+}
+
+func TestEvaluateHand_MatchesBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for n := 5; n <= 8; n++ {
+		for i := 0; i < 3000; i++ {
+			cards := randomCards(rng, n)
+			want := bruteForceEvaluate(cards)
+			got, err := EvaluateHand(cards)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got.Rank != want.Rank || !reflect.DeepEqual(got.RankDetail, want.RankDetail) ||
+				got.Description != want.Description || !reflect.DeepEqual(got.Cards, want.Cards) {
+				t.Fatalf("Mismatch for %v: got %+v, want %+v", cards, got, want)
+			}
+		}
+	}
+}
+
+func TestRankTables_OrderMatchesCompare(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	for i := 0; i < 5000; i++ {
+		cards := randomCards(rng, 14)
+		a, b := cards[:7], cards[7:]
+		want := bruteForceEvaluate(a).Compare(bruteForceEvaluate(b))
+
+		sa := standardTables.evaluate(NewCardSet(a...))
+		sb := standardTables.evaluate(NewCardSet(b...))
+		got := 0
+		if sa > sb {
+			got = 1
+		} else if sa < sb {
+			got = -1
+		}
+		if got != want {
+			t.Fatalf("Table order %d disagrees with Compare %d for %v vs %v", got, want, a, b)
+		}
+	}
+}
+
+func BenchmarkEvaluateSevenCards(b *testing.B) {
+	rng := rand.New(rand.NewSource(3))
+	sets := make([]CardSet, 1024)
+	for i := range sets {
+		sets[i] = NewCardSet(randomCards(rng, 7)...)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		standardTables.evaluate(sets[i%len(sets)])
+	}
+}
+
+func BenchmarkCalculateWinProbability(b *testing.B) {
+	hole, _ := ParseCards([]string{"HA", "SA"})
+	for i := 0; i < b.N; i++ {
+		CalculateWinProbability(hole, nil, 9, 10000)
+	}
+}
@@ -0,0 +1,204 @@
+package poker
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// SuitTexture describes how the suits of a board are spread, by the most
+// cards it holds of any one suit
+type SuitTexture string
+
+const (
+	Monotone SuitTexture = "monotone" // three or more cards of a suit: a flush is possible
+	TwoTone  SuitTexture = "two-tone" // at most two of a suit: a flush draw is possible
+	Rainbow  SuitTexture = "rainbow"  // no two cards of a suit
+)
+
+// Wetness grades how many hands connect strongly with a board
+type Wetness string
+
+const (
+	Dry     Wetness = "dry"      // few hands hold a straight, a flush or a strong draw
+	SemiWet Wetness = "semi-wet" // some do
+	Wet     Wetness = "wet"      // many do
+)
+
+// wetnessLevels are the draw-or-better frequencies from which a board is
+// semi-wet and wet
+var wetnessLevels = [2]float64{0.05, 0.2}
+
+// PossibleStraight is a straight two hole cards can make on the board
+type PossibleStraight struct {
+	High  int   `json:"high"`  // top rank of the straight, 5 for the wheel
+	Needs []int `json:"needs"` // ranks the hole cards must supply
+}
+
+// PossibleFlush is a suit a flush can be made in
+type PossibleFlush struct {
+	Suit  string `json:"suit"`
+	Needs int    `json:"needs"` // hole cards of the suit needed: 0, 1 or 2
+}
+
+// CategoryFrequency is how many two-card holdings make a category on the board
+type CategoryFrequency struct {
+	Rank      HandRank `json:"rank"`
+	Combos    int      `json:"combos"`
+	Frequency float64  `json:"frequency"` // share of every holding
+}
+
+// BoardTexture describes a board the way players read it
+type BoardTexture struct {
+	Paired        bool                `json:"paired"`        // two or more cards share a rank
+	TripsOnBoard  bool                `json:"tripsOnBoard"`  // three or more cards share a rank
+	Suits         SuitTexture         `json:"suits"`         // monotone, two-tone or rainbow
+	MaxSuited     int                 `json:"maxSuited"`     // the most cards of any one suit
+	Connectedness int                 `json:"connectedness"` // the most board ranks inside one straight
+	Straights     []PossibleStraight  `json:"straights"`     // every straight two hole cards can make, highest first
+	Flushes       []PossibleFlush     `json:"flushes"`
+	Categories    []CategoryFrequency `json:"categories"` // the categories two hole cards can make, strongest first under the game's rules
+	Nuts          string              `json:"nuts"`       // the best hand possible
+
+	// DrawFrequency is the share of holdings with a flush draw, an
+	// open-ended straight draw or a double gutshot (flop and turn only)
+	DrawFrequency float64 `json:"drawFrequency"`
+
+	// WetFrequency is the share of holdings with a straight or a flush
+	// better than the board itself, or one of those draws; Wetness grades it
+	WetFrequency float64 `json:"wetFrequency"`
+	Wetness      Wetness `json:"wetness"`
+}
+
+// AnalyzeBoard describes a Hold'em board of 3 to 5 community cards
+func AnalyzeBoard(board []Card) (*BoardTexture, error) {
+	return Holdem.AnalyzeBoard(board)
+}
+
+// AnalyzeBoard describes a board of 3 to 5 community cards in a game with
+// two hole cards. The categories and frequencies come from every two-card
+// holding the rest of the deck allows.
+func (g Game) AnalyzeBoard(board []Card) (*BoardTexture, error) {
+	if g.HoleCards() != 2 || !g.HasBoard() {
+		return nil, fmt.Errorf("board analysis needs a game with two hole cards and a board, not %s", g)
+	}
+	if len(board) < 3 || len(board) > 5 {
+		return nil, fmt.Errorf("a board has 3 to 5 community cards, not %d", len(board))
+	}
+	if HasDuplicates(board) {
+		return nil, fmt.Errorf("duplicate cards detected")
+	}
+	cs := NewCardSet(board...)
+	if cs.Remove(g.Deck()) != 0 {
+		return nil, fmt.Errorf("cards must come from the %s deck", g)
+	}
+
+	texture := &BoardTexture{}
+	switch pairedRank(cs) {
+	case ThreeOfAKind, FullHouse, FourOfAKind:
+		texture.TripsOnBoard = true
+		texture.Paired = true
+	case OnePair, TwoPair:
+		texture.Paired = true
+	}
+
+	// Suits
+	for s := 0; s < 4; s++ {
+		n := bits.OnesCount16(cs.suitMask(s))
+		texture.MaxSuited = max(texture.MaxSuited, n)
+		if n >= 3 {
+			texture.Flushes = append(texture.Flushes, PossibleFlush{Suit: string(suitOrder[s]), Needs: max(0, 5-n)})
+		}
+	}
+	switch {
+	case texture.MaxSuited >= 3:
+		texture.Suits = Monotone
+	case texture.MaxSuited == 2:
+		texture.Suits = TwoTone
+	default:
+		texture.Suits = Rainbow
+	}
+
+	// Straights: every window holding three or more board ranks, highest first
+	lowest := g.lowestRank()
+	ranks := straightRanks(cs, lowest)
+	windows := straightWindows(lowest)
+	for i := len(windows) - 1; i >= 0; i-- {
+		window := windows[i]
+		n := bits.OnesCount16(ranks & window)
+		texture.Connectedness = max(texture.Connectedness, n)
+		if n < 3 {
+			continue
+		}
+		straight := PossibleStraight{High: 15 - bits.LeadingZeros16(window), Needs: []int{}}
+		for m := window &^ ranks; m != 0; m &= m - 1 {
+			rank := bits.TrailingZeros16(m)
+			if rank < lowest {
+				rank = 14 // the ace playing low
+			}
+			straight.Needs = append(straight.Needs, rank)
+		}
+		texture.Straights = append(texture.Straights, straight)
+	}
+
+	// Every two-card holding
+	var counts [RoyalFlush + 1]int
+	var nuts *Hand
+	total, draws, wet := 0, 0, 0
+	onBoard := boardRank(g, board)
+	unseen := g.Deck().Remove(cs)
+	ids := unseen.IDs()
+	for i := 0; i < len(ids); i++ {
+		for j := i + 1; j < len(ids); j++ {
+			hole := []Card{ids[i].Card(), ids[j].Card()}
+			hand, err := g.Evaluate(hole, board)
+			if err != nil {
+				return nil, err
+			}
+			total++
+			counts[hand.Rank]++
+			if nuts == nil || hand.Compare(nuts) > 0 {
+				nuts = hand
+			}
+
+			strong := false
+			switch hand.Rank {
+			case Straight, Flush, StraightFlush, RoyalFlush:
+				strong = hand.outranks(onBoard)
+			}
+			if len(board) < 5 {
+				for _, draw := range findDraws(g, hole, board, hand, unseen.Remove(NewCardSet(hole...))) {
+					if draw.Type == FlushDraw || draw.Type == OpenEndedDraw || draw.Type == DoubleGutshot {
+						draws++
+						strong = true
+						break
+					}
+				}
+			}
+			if strong {
+				wet++
+			}
+		}
+	}
+
+	for _, rank := range g.categories() {
+		if counts[rank] > 0 {
+			texture.Categories = append(texture.Categories, CategoryFrequency{
+				Rank:      rank,
+				Combos:    counts[rank],
+				Frequency: float64(counts[rank]) / float64(total),
+			})
+		}
+	}
+	texture.Nuts = nuts.Description
+	texture.DrawFrequency = float64(draws) / float64(total)
+	texture.WetFrequency = float64(wet) / float64(total)
+	switch {
+	case texture.WetFrequency >= wetnessLevels[1]:
+		texture.Wetness = Wet
+	case texture.WetFrequency >= wetnessLevels[0]:
+		texture.Wetness = SemiWet
+	default:
+		texture.Wetness = Dry
+	}
+	return texture, nil
+}
@@ -0,0 +1,164 @@
+package poker
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAnalyzeBoard_Texture(t *testing.T) {
+	tests := []struct {
+		board         []string
+		paired, trips bool
+		suits         SuitTexture
+		connectedness int
+		wetness       Wetness
+	}{
+		{[]string{"HK", "D7", "C2"}, false, false, Rainbow, 1, Dry},
+		{[]string{"HA", "DA", "CA"}, true, true, Rainbow, 1, Dry},
+		{[]string{"HQ", "DQ", "C5"}, true, false, Rainbow, 1, Dry},
+		{[]string{"HK", "HQ", "C4"}, false, false, TwoTone, 2, SemiWet},
+		{[]string{"H9", "H8", "D7"}, false, false, TwoTone, 3, Wet},
+		{[]string{"HJ", "HT", "H9"}, false, false, Monotone, 3, Wet},
+		{[]string{"H9", "H8", "D7", "C6"}, false, false, TwoTone, 4, Wet},
+		{[]string{"HK", "D7", "C2", "ST"}, false, false, Rainbow, 2, Dry},
+		{[]string{"HK", "H7", "C2", "HT"}, false, false, Monotone, 2, Wet},
+		{[]string{"HK", "D7", "C2", "ST", "H4"}, false, false, TwoTone, 2, Dry},
+	}
+
+	for _, tt := range tests {
+		board, _ := ParseCards(tt.board)
+		texture, err := AnalyzeBoard(board)
+		if err != nil {
+			t.Fatalf("%v: Unexpected error: %v", tt.board, err)
+		}
+		if texture.Paired != tt.paired || texture.TripsOnBoard != tt.trips {
+			t.Errorf("%v: Expected paired %v trips %v, got %v %v", tt.board, tt.paired, tt.trips, texture.Paired, texture.TripsOnBoard)
+		}
+		if texture.Suits != tt.suits {
+			t.Errorf("%v: Expected %s, got %s", tt.board, tt.suits, texture.Suits)
+		}
+		if texture.Connectedness != tt.connectedness {
+			t.Errorf("%v: Expected connectedness %d, got %d", tt.board, tt.connectedness, texture.Connectedness)
+		}
+		if texture.Wetness != tt.wetness {
+			t.Errorf("%v: Expected %s, got %s (%.3f)", tt.board, tt.wetness, texture.Wetness, texture.WetFrequency)
+		}
+	}
+}
+
+func TestAnalyzeBoard_Straights(t *testing.T) {
+	tests := []struct {
+		game      Game
+		board     []string
+		straights []PossibleStraight
+	}{
+		{Holdem, []string{"H9", "H8", "D7"}, []PossibleStraight{{11, []int{10, 11}}, {10, []int{6, 10}}, {9, []int{5, 6}}}},
+		{Holdem, []string{"SA", "D5", "C3"}, []PossibleStraight{{5, []int{2, 4}}}},
+		{Holdem, []string{"HK", "D7", "C2"}, nil},
+		{ShortDeck, []string{"SA", "D7", "C6"}, []PossibleStraight{{9, []int{8, 9}}}},
+	}
+
+	for _, tt := range tests {
+		board, _ := ParseCards(tt.board)
+		texture, err := tt.game.AnalyzeBoard(board)
+		if err != nil {
+			t.Fatalf("%v: Unexpected error: %v", tt.board, err)
+		}
+		if !reflect.DeepEqual(texture.Straights, tt.straights) {
+			t.Errorf("%s %v: Expected straights %v, got %v", tt.game, tt.board, tt.straights, texture.Straights)
+		}
+	}
+}
+
+func TestAnalyzeBoard_Flushes(t *testing.T) {
+	tests := []struct {
+		board   []string
+		flushes []PossibleFlush
+	}{
+		{[]string{"HJ", "HT", "H9"}, []PossibleFlush{{"H", 2}}},
+		{[]string{"S2", "S7", "SK", "SQ"}, []PossibleFlush{{"S", 1}}},
+		{[]string{"D2", "D7", "DK", "C4", "DA"}, []PossibleFlush{{"D", 1}}},
+		{[]string{"H9", "H8", "D7"}, nil},
+	}
+
+	for _, tt := range tests {
+		board, _ := ParseCards(tt.board)
+		texture, err := AnalyzeBoard(board)
+		if err != nil {
+			t.Fatalf("%v: Unexpected error: %v", tt.board, err)
+		}
+		if !reflect.DeepEqual(texture.Flushes, tt.flushes) {
+			t.Errorf("%v: Expected flushes %v, got %v", tt.board, tt.flushes, texture.Flushes)
+		}
+	}
+}
+
+func TestAnalyzeBoard_Categories(t *testing.T) {
+	board, _ := ParseCards([]string{"HK", "D7", "C2"})
+	texture, err := AnalyzeBoard(board)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := []HandRank{ThreeOfAKind, TwoPair, OnePair, HighCard}
+	total := 0
+	for i, category := range texture.Categories {
+		if i >= len(want) || category.Rank != want[i] {
+			t.Fatalf("Expected categories %v, got %+v", want, texture.Categories)
+		}
+		total += category.Combos
+	}
+	if total != 49*48/2 {
+		t.Errorf("Expected every one of %d holdings counted, got %d", 49*48/2, total)
+	}
+	if texture.Nuts != "Three of a Kind, Kings" {
+		t.Errorf("Expected a set of kings as the nuts, got %q", texture.Nuts)
+	}
+
+	board, _ = ParseCards([]string{"HJ", "HT", "H9"})
+	texture, _ = AnalyzeBoard(board)
+	if texture.Categories[0].Rank != StraightFlush || texture.Nuts != "Straight Flush, King high" {
+		t.Errorf("Expected a king-high straight flush as the nuts, got %q", texture.Nuts)
+	}
+	if texture.DrawFrequency == 0 {
+		t.Error("Expected draws on a monotone flop")
+	}
+}
+
+func TestAnalyzeBoard_ShortDeckCategories(t *testing.T) {
+	// A flush beats a full house and trips beat a straight in short deck
+	board, _ := ParseCards([]string{"H9", "H8", "H7"})
+	texture, err := ShortDeck.AnalyzeBoard(board)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := []HandRank{StraightFlush, Flush, ThreeOfAKind, Straight, TwoPair, OnePair, HighCard}
+	got := make([]HandRank, len(texture.Categories))
+	for i, category := range texture.Categories {
+		got[i] = category.Rank
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected categories %v, got %v", want, got)
+	}
+}
+
+func TestAnalyzeBoard_Errors(t *testing.T) {
+	tests := []struct {
+		game  Game
+		board []string
+	}{
+		{Holdem, []string{"HK", "D7"}},
+		{Holdem, []string{"HK", "D7", "C2", "ST", "H4", "S9"}},
+		{Holdem, []string{"HK", "HK", "C2"}},
+		{ShortDeck, []string{"HK", "D2", "C7"}},
+		{Omaha, []string{"HK", "D7", "C2"}},
+	}
+
+	for _, tt := range tests {
+		board, _ := ParseCards(tt.board)
+		if _, err := tt.game.AnalyzeBoard(board); err == nil {
+			t.Errorf("%s %v: Expected an error", tt.game, tt.board)
+		}
+	}
+}